@@ -0,0 +1,152 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"crypto/cipher"
+	"encoding/binary"
+	"strconv"
+)
+
+// The key size of the W block cipher in bytes. Its block size is BlockSize.
+const KeySize = 64
+
+// KeySizeError is returned by NewCipher for keys that are not KeySize bytes.
+type KeySizeError int
+
+func (k KeySizeError) Error() string {
+	return "whirlpool: invalid key size " + strconv.Itoa(int(k))
+}
+
+// wCipher is an instance of W with a precomputed key schedule.
+type wCipher struct {
+	enc [rounds + 1][8]uint64 // Round keys K^0 to K^rounds.
+	dec [rounds + 1][8]uint64 // θ^-1(K^r), for the inverse rounds.
+}
+
+// NewCipher returns the W block cipher underlying the final whirlpool, keyed
+// with a KeySize byte key. W is not a standardised block cipher and is only
+// exposed for research and compatibility.
+func NewCipher(key []byte) (cipher.Block, error) {
+	if len(key) != KeySize {
+		return nil, KeySizeError(len(key))
+	}
+
+	c := new(wCipher)
+	for i := 0; i < 8; i++ {
+		c.enc[0][i] = binary.BigEndian.Uint64(key[8*i:])
+	}
+	for r := 1; r <= rounds; r++ {
+		c.enc[r] = round(&c.enc[r-1])
+		c.enc[r][0] ^= rc[r]
+	}
+	for r := 0; r <= rounds; r++ {
+		c.dec[r] = thetaInv(&c.enc[r])
+	}
+	return c, nil
+}
+
+func (c *wCipher) BlockSize() int {
+	return BlockSize
+}
+
+func (c *wCipher) Encrypt(dst, src []byte) {
+	if len(src) < BlockSize {
+		panic("whirlpool: input not full block")
+	}
+	if len(dst) < BlockSize {
+		panic("whirlpool: output not full block")
+	}
+
+	var state [8]uint64
+	for i := 0; i < 8; i++ {
+		state[i] = binary.BigEndian.Uint64(src[8*i:]) ^ c.enc[0][i]
+	}
+	for r := 1; r <= rounds; r++ {
+		state = round(&state)
+		for i := 0; i < 8; i++ {
+			state[i] ^= c.enc[r][i]
+		}
+	}
+	for i := 0; i < 8; i++ {
+		binary.BigEndian.PutUint64(dst[8*i:], state[i])
+	}
+}
+
+// Decrypt runs the rounds backwards on u = θ^-1(state), so that the inverse
+// S-box and θ^-1 of each round fold into the _Cinv lookups.
+func (c *wCipher) Decrypt(dst, src []byte) {
+	if len(src) < BlockSize {
+		panic("whirlpool: input not full block")
+	}
+	if len(dst) < BlockSize {
+		panic("whirlpool: output not full block")
+	}
+
+	var u [8]uint64
+	for i := 0; i < 8; i++ {
+		u[i] = binary.BigEndian.Uint64(src[8*i:])
+	}
+	u = thetaInv(&u)
+	for r := rounds; r > 1; r-- {
+		for i := 0; i < 8; i++ {
+			u[i] ^= c.dec[r][i]
+		}
+		u = roundInv(&u)
+	}
+
+	// The first round has no θ^-1 left to apply.
+	for i := 0; i < 8; i++ {
+		u[i] ^= c.dec[1][i]
+	}
+	for i := 0; i < 8; i++ {
+		var s uint64
+		for t := 0; t < 8; t++ {
+			s = s<<8 | uint64(_Sinv[byte(u[(i+t)%8]>>(56-8*uint(t)))])
+		}
+		binary.BigEndian.PutUint64(dst[8*i:], s^c.enc[0][i])
+	}
+}
+
+// round computes θπγ(x), one round of W without the key addition.
+func round(x *[8]uint64) (L [8]uint64) {
+	for i := 0; i < 8; i++ {
+		L[i] = _C0[byte(x[i%8]>>56)] ^
+			_C1[byte(x[(i+7)%8]>>48)] ^
+			_C2[byte(x[(i+6)%8]>>40)] ^
+			_C3[byte(x[(i+5)%8]>>32)] ^
+			_C4[byte(x[(i+4)%8]>>24)] ^
+			_C5[byte(x[(i+3)%8]>>16)] ^
+			_C6[byte(x[(i+2)%8]>>8)] ^
+			_C7[byte(x[(i+1)%8])]
+	}
+	return
+}
+
+// roundInv computes θ^-1 γ^-1 π^-1(x).
+func roundInv(x *[8]uint64) (L [8]uint64) {
+	for i := 0; i < 8; i++ {
+		L[i] = _Cinv[0][byte(x[i%8]>>56)] ^
+			_Cinv[1][byte(x[(i+1)%8]>>48)] ^
+			_Cinv[2][byte(x[(i+2)%8]>>40)] ^
+			_Cinv[3][byte(x[(i+3)%8]>>32)] ^
+			_Cinv[4][byte(x[(i+4)%8]>>24)] ^
+			_Cinv[5][byte(x[(i+5)%8]>>16)] ^
+			_Cinv[6][byte(x[(i+6)%8]>>8)] ^
+			_Cinv[7][byte(x[(i+7)%8])]
+	}
+	return
+}
+
+// thetaInv computes θ^-1(x). _Cinv includes the inverse S-box, which the
+// forward S-box, the top byte of _C0, cancels.
+func thetaInv(x *[8]uint64) (L [8]uint64) {
+	for i := 0; i < 8; i++ {
+		for t := 0; t < 8; t++ {
+			L[i] ^= _Cinv[t][byte(_C0[byte(x[i]>>(56-8*uint(t)))]>>56)]
+		}
+	}
+	return
+}
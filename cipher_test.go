@@ -0,0 +1,104 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// TestCipherMiyaguchiPreneel checks W against the hash: for messages that
+// fit in one block, whirlpool(m) = W_0(pad(m)) ^ pad(m).
+func TestCipherMiyaguchiPreneel(t *testing.T) {
+	c, err := whirlpool.NewCipher(make([]byte, whirlpool.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, g := range golden {
+		if len(g.in) >= whirlpool.BlockSize-32 {
+			continue
+		}
+		block := make([]byte, whirlpool.BlockSize)
+		copy(block, g.in)
+		block[len(g.in)] = 0x80
+		block[whirlpool.BlockSize-2] = byte(len(g.in) * 8 >> 8)
+		block[whirlpool.BlockSize-1] = byte(len(g.in) * 8)
+
+		out := make([]byte, whirlpool.BlockSize)
+		c.Encrypt(out, block)
+		for i := range out {
+			out[i] ^= block[i]
+		}
+		if s := fmt.Sprintf("%X", out); s != g.out {
+			t.Fatalf("W_0(pad(%q)) ^ pad = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	key := make([]byte, whirlpool.KeySize)
+	src := make([]byte, whirlpool.BlockSize)
+	enc := make([]byte, whirlpool.BlockSize)
+	dec := make([]byte, whirlpool.BlockSize)
+	for i := 0; i < 100; i++ {
+		rnd.Read(key)
+		rnd.Read(src)
+		c, err := whirlpool.NewCipher(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.Encrypt(enc, src)
+		if bytes.Equal(enc, src) {
+			t.Fatalf("Encrypt(%x) is the identity", src)
+		}
+		c.Decrypt(dec, enc)
+		if !bytes.Equal(dec, src) {
+			t.Fatalf("Decrypt(Encrypt(%x)) = %x", src, dec)
+		}
+
+		// In-place operation.
+		copy(dec, src)
+		c.Encrypt(dec, dec)
+		c.Decrypt(dec, dec)
+		if !bytes.Equal(dec, src) {
+			t.Fatalf("in-place Decrypt(Encrypt(%x)) = %x", src, dec)
+		}
+	}
+}
+
+func TestCipherModes(t *testing.T) {
+	c, _ := whirlpool.NewCipher(bytes.Repeat([]byte{0x42}, whirlpool.KeySize))
+	iv := make([]byte, c.BlockSize())
+	msg := bytes.Repeat([]byte("0123456789abcdef"), 16)
+
+	enc := make([]byte, len(msg))
+	cipher.NewCBCEncrypter(c, iv).CryptBlocks(enc, msg)
+	dec := make([]byte, len(msg))
+	cipher.NewCBCDecrypter(c, iv).CryptBlocks(dec, enc)
+	if !bytes.Equal(dec, msg) {
+		t.Fatal("CBC round trip failed")
+	}
+
+	cipher.NewCTR(c, iv).XORKeyStream(enc, msg)
+	cipher.NewCTR(c, iv).XORKeyStream(dec, enc)
+	if !bytes.Equal(dec, msg) {
+		t.Fatal("CTR round trip failed")
+	}
+}
+
+func TestCipherKeySize(t *testing.T) {
+	for _, n := range []int{0, 16, 32, 63, 65, 128} {
+		_, err := whirlpool.NewCipher(make([]byte, n))
+		if err != whirlpool.KeySizeError(n) {
+			t.Errorf("NewCipher(%d-byte key) = %v want %v", n, err, whirlpool.KeySizeError(n))
+		}
+	}
+}
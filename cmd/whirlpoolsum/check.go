@@ -0,0 +1,286 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// checkTotals counts the outcomes of verifying one checksum file.
+type checkTotals struct {
+	verified  int // Listed files that were hashed, matching or not.
+	formatted int // Properly formatted lines.
+	malformed int // Improperly formatted lines.
+	failed    int // Checksums that did not match.
+	unread    int // Listed files that could not be read.
+	missing   int // Listed files that do not exist.
+}
+
+// check verifies every checksum file named in opts.files and returns the
+// exit status.
+func check(opts *options, stdin io.Reader, stdout, stderr io.Writer) int {
+	status := 0
+	for _, name := range opts.files {
+		if !checkFile(opts, name, stdin, stdout, stderr) {
+			status = 1
+		}
+	}
+	return status
+}
+
+// checkFile verifies the checksum file name and reports whether every
+// listed file matched.
+func checkFile(opts *options, name string, stdin io.Reader, stdout, stderr io.Writer) bool {
+	r := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintf(stderr, "whirlpoolsum: %s: %s\n", name, describe(err))
+			return false
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var (
+		totals  checkTotals
+		columns []string // Columns of a hashdeep header, if any.
+	)
+	br := bufio.NewReaderSize(r, maxLine)
+	delim := byte('\n')
+	if opts.zero {
+		delim = 0
+	}
+	for lineno := 1; ; lineno++ {
+		record, tooLong, err := readRecord(br, delim)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Fprintf(stderr, "whirlpoolsum: %s: %s\n", name, describe(err))
+			return false
+		}
+		line := strings.TrimSuffix(string(record), "\r")
+
+		// hashdeep headers describe the columns of the lines that follow.
+		if !tooLong && strings.HasPrefix(line, "%%%% ") {
+			if strings.HasPrefix(line, "%%%% size,") {
+				columns = strings.Split(line[len("%%%% "):], ",")
+			}
+			continue
+		}
+		if !tooLong && (strings.HasPrefix(line, "##") || (columns != nil && line == "")) {
+			continue
+		}
+
+		file, sum, ok := parseCheckLine(line, columns)
+		if !ok || tooLong {
+			totals.malformed++
+			if !opts.status {
+				fmt.Fprintf(stderr, "whirlpoolsum: %s: %d: improperly formatted WHIRLPOOL checksum line\n", name, lineno)
+			}
+			continue
+		}
+		totals.formatted++
+
+		display := file
+		if strings.ContainsAny(file, "\\\n\r") {
+			display = "\\" + escaper.Replace(file)
+		}
+
+		got, err := sumFile(file, stdin)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			if opts.ignoreMissing {
+				continue
+			}
+			totals.missing++
+			if !opts.status {
+				fmt.Fprintf(stdout, "%s: MISSING\n", display)
+			}
+		case err != nil:
+			totals.unread++
+			if !opts.status {
+				fmt.Fprintf(stderr, "whirlpoolsum: %s: %s\n", file, describe(err))
+				fmt.Fprintf(stdout, "%s: FAILED open or read\n", display)
+			}
+		case !bytes.Equal(got, sum):
+			totals.verified++
+			totals.failed++
+			if !opts.status {
+				fmt.Fprintf(stdout, "%s: FAILED\n", display)
+			}
+		default:
+			totals.verified++
+			if !opts.status && !opts.quiet {
+				fmt.Fprintf(stdout, "%s: OK\n", display)
+			}
+		}
+	}
+
+	if totals.formatted == 0 {
+		fmt.Fprintf(stderr, "whirlpoolsum: %s: no properly formatted WHIRLPOOL checksum lines found\n", name)
+		return false
+	}
+	if !opts.status {
+		warn(stderr, totals.malformed, "line is improperly formatted", "lines are improperly formatted")
+		warn(stderr, totals.missing, "listed file is missing", "listed files are missing")
+		warn(stderr, totals.unread, "listed file could not be read", "listed files could not be read")
+		warn(stderr, totals.failed, "computed checksum did NOT match", "computed checksums did NOT match")
+	}
+	if opts.ignoreMissing && totals.verified+totals.unread == 0 {
+		fmt.Fprintf(stderr, "whirlpoolsum: %s: no file was verified\n", name)
+		return false
+	}
+
+	return totals.failed == 0 && totals.unread == 0 && totals.missing == 0 &&
+		(!opts.strict || totals.malformed == 0)
+}
+
+func warn(w io.Writer, n int, singular, plural string) {
+	switch {
+	case n == 1:
+		fmt.Fprintf(w, "whirlpoolsum: WARNING: 1 %s\n", singular)
+	case n > 1:
+		fmt.Fprintf(w, "whirlpoolsum: WARNING: %d %s\n", n, plural)
+	}
+}
+
+// parseCheckLine parses one line of a checksum file in any of the supported
+// formats. The columns of a preceding hashdeep header select the CSV
+// format.
+func parseCheckLine(line string, columns []string) (file string, sum []byte, ok bool) {
+	if columns != nil {
+		return parseHashdeepLine(line, columns)
+	}
+
+	// A leading backslash marks an escaped file name.
+	escaped := strings.HasPrefix(line, "\\")
+	if escaped {
+		line = line[1:]
+	}
+
+	switch {
+	case strings.HasPrefix(line, "WHIRLPOOL ("):
+		// BSD: WHIRLPOOL (file) = hex
+		i := strings.LastIndex(line, ") = ")
+		if i < 0 {
+			return "", nil, false
+		}
+		file, sum, ok = line[len("WHIRLPOOL ("):i], decodeSum(line[i+len(") = "):]), true
+	case len(line) > 2*whirlpool.Size+2 && line[2*whirlpool.Size] == ' ' &&
+		(line[2*whirlpool.Size+1] == ' ' || line[2*whirlpool.Size+1] == '*'):
+		// GNU: hex, a space, a space or '*', file.
+		file, sum, ok = line[2*whirlpool.Size+2:], decodeSum(line[:2*whirlpool.Size]), true
+	default:
+		// Headerless hashdeep or md5deep CSV: size,hex,file
+		return parseHashdeepLine(line, []string{"size", "whirlpool", "filename"})
+	}
+
+	if sum == nil || file == "" {
+		return "", nil, false
+	}
+	if escaped {
+		if file, ok = unescape(file); !ok {
+			return "", nil, false
+		}
+	}
+	return file, sum, true
+}
+
+// parseHashdeepLine parses a CSV line with the given columns. The file name
+// is the last column and may itself contain commas.
+func parseHashdeepLine(line string, columns []string) (file string, sum []byte, ok bool) {
+	fields := strings.SplitN(line, ",", len(columns))
+	if len(fields) != len(columns) || columns[len(columns)-1] != "filename" {
+		return "", nil, false
+	}
+	for i, column := range columns {
+		switch column {
+		case "size":
+			if fields[i] == "" || strings.Trim(fields[i], "0123456789") != "" {
+				return "", nil, false
+			}
+		case "whirlpool":
+			sum = decodeSum(fields[i])
+		case "filename":
+			file = fields[i]
+		}
+	}
+	if sum == nil || file == "" {
+		return "", nil, false
+	}
+	return file, sum, true
+}
+
+// decodeSum decodes a checksum in upper or lower case hex, or returns nil.
+func decodeSum(s string) []byte {
+	if len(s) != 2*whirlpool.Size {
+		return nil
+	}
+	sum, err := hex.DecodeString(s)
+	if err != nil {
+		return nil
+	}
+	return sum
+}
+
+// unescape reverses the escaping of file names done by formatLine.
+func unescape(s string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i++; i == len(s) {
+			return "", false
+		}
+		switch s[i] {
+		case '\\':
+			b.WriteByte('\\')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		default:
+			return "", false
+		}
+	}
+	return b.String(), true
+}
+
+// maxLine is the length of the longest manifest line that is parsed. Longer
+// lines are counted as improperly formatted.
+const maxLine = 1 << 20
+
+// readRecord returns the next record of r, up to but not including delim. A
+// record that does not fit in the buffer of r is skipped and reported as too
+// long. It returns io.EOF once no record remains.
+func readRecord(r *bufio.Reader, delim byte) (record []byte, tooLong bool, err error) {
+	record, err = r.ReadSlice(delim)
+	for err == bufio.ErrBufferFull {
+		tooLong = true
+		record, err = r.ReadSlice(delim)
+	}
+	if err == io.EOF && (len(record) > 0 || tooLong) {
+		// The last record has no delimiter.
+		err = nil
+	}
+	if tooLong {
+		record = nil
+	}
+	return bytes.TrimSuffix(record, []byte{delim}), tooLong, err
+}
@@ -0,0 +1,171 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var badSum = strings.Repeat("0", len(abcSum))
+
+func TestCheckFormats(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc", "empty", "", "a,b", "abc")
+	dir := filepath.Dir(paths[0])
+
+	manifests := map[string]string{
+		"gnu": abcSum + "  " + paths[0] + "\n" +
+			strings.ToUpper(emptySum) + " *" + paths[1] + "\n",
+		"bsd": "WHIRLPOOL (" + paths[0] + ") = " + abcSum + "\n" +
+			"WHIRLPOOL (" + paths[1] + ") = " + emptySum + "\n",
+		"hashdeep": "%%%% HASHDEEP-1.0\n" +
+			"%%%% size,md5,whirlpool,filename\n" +
+			"## Invoked from: " + dir + "\n" +
+			"##\n" +
+			"3,900150983cd24fb0d6963f7d28e17f72," + abcSum + "," + paths[0] + "\n" +
+			"0,d41d8cd98f00b204e9800998ecf8427e," + emptySum + "," + paths[1] + "\n",
+		"csv": "3," + abcSum + "," + paths[0] + "\n" +
+			"0," + emptySum + "," + paths[1] + "\n",
+		"crlf": abcSum + "  " + paths[0] + "\r\n" +
+			emptySum + "  " + paths[1] + "\r\n",
+	}
+	want := paths[0] + ": OK\n" + paths[1] + ": OK\n"
+	for format, manifest := range manifests {
+		status, stdout, stderr := runCommand(manifest, "-c")
+		if status != 0 || stdout != want || stderr != "" {
+			t.Errorf("%s: whirlpoolsum -c = %d, %q, %q want 0, %q", format, status, stdout, stderr, want)
+		}
+	}
+
+	// File names may contain commas in CSV.
+	status, stdout, _ := runCommand("3,"+abcSum+","+paths[2]+"\n", "-c")
+	if want := paths[2] + ": OK\n"; status != 0 || stdout != want {
+		t.Errorf("csv with comma: whirlpoolsum -c = %d, %q want 0, %q", status, stdout, want)
+	}
+}
+
+func TestCheckRoundTrip(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc", "a\\b\nc", "abc")
+	manifest := filepath.Join(t.TempDir(), "SUMS")
+
+	for _, args := range [][]string{nil, {"--tag"}, {"-z"}} {
+		_, sums, _ := runCommand("", append(args, paths...)...)
+		if err := os.WriteFile(manifest, []byte(sums), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		checkArgs := []string{"-c", "--quiet", manifest}
+		if len(args) > 0 && args[0] == "-z" {
+			checkArgs = append(checkArgs, "-z")
+		}
+		if status, stdout, stderr := runCommand("", checkArgs...); status != 0 || stdout != "" || stderr != "" {
+			t.Errorf("whirlpoolsum %q = %d, %q, %q", checkArgs, status, stdout, stderr)
+		}
+	}
+}
+
+func TestCheckFailures(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc", "other.txt", "xyz")
+	missing := filepath.Join(filepath.Dir(paths[0]), "missing")
+	manifest := abcSum + "  " + paths[0] + "\n" +
+		"not a checksum line\n" +
+		abcSum + "  " + paths[1] + "\n" +
+		badSum + "  " + missing + "\n" +
+		"WHIRLPOOL (" + paths[0] + ") = abc\n"
+
+	status, stdout, stderr := runCommand(manifest, "-c")
+	if status != 1 {
+		t.Errorf("exit status %d want 1", status)
+	}
+	wantOut := paths[0] + ": OK\n" + paths[1] + ": FAILED\n" + missing + ": MISSING\n"
+	if stdout != wantOut {
+		t.Errorf("stdout %q want %q", stdout, wantOut)
+	}
+	wantErr := "whirlpoolsum: -: 2: improperly formatted WHIRLPOOL checksum line\n" +
+		"whirlpoolsum: -: 5: improperly formatted WHIRLPOOL checksum line\n" +
+		"whirlpoolsum: WARNING: 2 lines are improperly formatted\n" +
+		"whirlpoolsum: WARNING: 1 listed file is missing\n" +
+		"whirlpoolsum: WARNING: 1 computed checksum did NOT match\n"
+	if stderr != wantErr {
+		t.Errorf("stderr %q want %q", stderr, wantErr)
+	}
+
+	// --quiet drops the OK lines, --status drops everything.
+	_, stdout, _ = runCommand(manifest, "-c", "--quiet")
+	if want := paths[1] + ": FAILED\n" + missing + ": MISSING\n"; stdout != want {
+		t.Errorf("--quiet stdout %q want %q", stdout, want)
+	}
+	status, stdout, stderr = runCommand(manifest, "-c", "--status")
+	if status != 1 || stdout != "" || stderr != "" {
+		t.Errorf("--status = %d, %q, %q want 1, \"\", \"\"", status, stdout, stderr)
+	}
+}
+
+func TestCheckStrictAndMissing(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc")
+	missing := filepath.Join(filepath.Dir(paths[0]), "missing")
+	good := abcSum + "  " + paths[0] + "\n"
+	malformed := good + "garbage\n"
+	withMissing := good + badSum + "  " + missing + "\n"
+
+	tests := []struct {
+		stdin  string
+		args   []string
+		status int
+	}{
+		{malformed, []string{"-c"}, 0},
+		{malformed, []string{"-c", "--strict"}, 1},
+		{withMissing, []string{"-c"}, 1},
+		{withMissing, []string{"-c", "--ignore-missing"}, 0},
+		{badSum + "  " + missing + "\n", []string{"-c", "--ignore-missing"}, 1},
+		{"garbage\n", []string{"-c"}, 1},
+	}
+	for _, tt := range tests {
+		if status, _, stderr := runCommand(tt.stdin, tt.args...); status != tt.status {
+			t.Errorf("whirlpoolsum %q on %q = %d want %d (%s)", tt.args, tt.stdin, status, tt.status, stderr)
+		}
+	}
+
+	_, _, stderr := runCommand("garbage\n", "-c")
+	if want := "whirlpoolsum: -: 1: improperly formatted WHIRLPOOL checksum line\n" +
+		"whirlpoolsum: -: no properly formatted WHIRLPOOL checksum lines found\n"; stderr != want {
+		t.Errorf("stderr %q want %q", stderr, want)
+	}
+}
+
+// TestCheckLongLine checks that a line too long to parse is counted as
+// improperly formatted instead of ending the run.
+func TestCheckLongLine(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc")
+	good := abcSum + "  " + paths[0] + "\n"
+	manifest := good + strings.Repeat("x", 3<<20) + "\n" + good + strings.Repeat("y", 2<<20)
+
+	status, stdout, stderr := runCommand(manifest, "-c")
+	if status != 0 {
+		t.Errorf("exit status %d want 0", status)
+	}
+	if want := paths[0] + ": OK\n" + paths[0] + ": OK\n"; stdout != want {
+		t.Errorf("stdout %q want %q", stdout, want)
+	}
+	wantErr := "whirlpoolsum: -: 2: improperly formatted WHIRLPOOL checksum line\n" +
+		"whirlpoolsum: -: 4: improperly formatted WHIRLPOOL checksum line\n" +
+		"whirlpoolsum: WARNING: 2 lines are improperly formatted\n"
+	if stderr != wantErr {
+		t.Errorf("stderr %q want %q", stderr, wantErr)
+	}
+}
+
+func TestCheckOptionErrors(t *testing.T) {
+	for _, args := range [][]string{
+		{"--quiet"}, {"--status"}, {"--strict"}, {"--ignore-missing"}, {"-w"},
+		{"-c", "--tag"}, {"-c", "-b"}, {"-ct"},
+	} {
+		status, _, stderr := runCommand("", args...)
+		if status != 1 || !strings.Contains(stderr, "Try 'whirlpoolsum --help'") {
+			t.Errorf("whirlpoolsum %q = %d, %q", args, status, stderr)
+		}
+	}
+}
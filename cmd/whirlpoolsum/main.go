@@ -0,0 +1,274 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command whirlpoolsum prints or checks whirlpool checksums. It accepts the
+// same options and produces the same output as sha256sum from GNU coreutils.
+//
+// With -c it verifies checksum files in the GNU format it prints, the BSD
+// format printed with --tag, and the CSV format of hashdeep. Improperly
+// formatted lines are reported with their line numbers and skipped.
+//
+// Usage:
+//
+//	whirlpoolsum [OPTION]... [FILE]...
+//
+// With no FILE, or when FILE is -, standard input is read.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+const usage = `Usage: whirlpoolsum [OPTION]... [FILE]...
+Print or check whirlpool (512-bit) checksums.
+
+With no FILE, or when FILE is -, read standard input.
+  -b, --binary          read in binary mode
+  -c, --check           read checksums from the FILEs and check them
+      --tag             create a BSD-style checksum
+  -t, --text            read in text mode (default)
+  -z, --zero            end each output line with NUL, not newline,
+                          and disable file name escaping
+
+The following five options are useful only when verifying checksums:
+      --ignore-missing  don't fail or report status for missing files
+      --quiet           don't print OK for each successfully verified file
+      --status          don't output anything, status code shows success
+      --strict          exit non-zero for improperly formatted checksum lines
+  -w, --warn            warn about improperly formatted checksum lines
+                          (always on)
+
+      --help        display this help and exit
+
+The default mode is to print a line with: checksum, a space,
+a character indicating input mode ('*' for binary, ' ' for text
+or where binary is insignificant), and name for each FILE.
+
+When checking, the input should be a former output of this program,
+a BSD-style checksum list or a hashdeep file with a whirlpool column.
+`
+
+// options holds the parsed command line.
+type options struct {
+	binary bool     // Mark files as read in binary mode.
+	text   bool     // Text mode was requested explicitly.
+	tag    bool     // Print BSD-style lines.
+	zero   bool     // End lines with NUL and do not escape names.
+	help   bool     // Print the usage and exit.
+	files  []string // Operands, "-" for standard input.
+
+	// Verification options.
+	check         bool // Verify checksum files instead of hashing.
+	ignoreMissing bool // Skip listed files that do not exist.
+	quiet         bool // Do not print OK lines.
+	status        bool // Print nothing, only set the exit status.
+	strict        bool // Fail on improperly formatted lines.
+	warn          bool // Accepted for compatibility; warnings are always on.
+}
+
+// usageError is a command line error, reported with a hint to use --help.
+type usageError string
+
+func (e usageError) Error() string { return string(e) }
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command and returns its exit status: 0 on success and 1
+// if an option was invalid, a file could not be read or, when checking, a
+// checksum did not match.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opts, err := parseArgs(args)
+	if err != nil {
+		fmt.Fprintf(stderr, "whirlpoolsum: %v\n", err)
+		fmt.Fprintln(stderr, "Try 'whirlpoolsum --help' for more information.")
+		return 1
+	}
+	if opts.help {
+		io.WriteString(stdout, usage)
+		return 0
+	}
+	if len(opts.files) == 0 {
+		opts.files = []string{"-"}
+	}
+	if opts.check {
+		return check(opts, stdin, stdout, stderr)
+	}
+
+	status := 0
+	for _, name := range opts.files {
+		sum, err := sumFile(name, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "whirlpoolsum: %s: %s\n", name, describe(err))
+			status = 1
+			continue
+		}
+		io.WriteString(stdout, formatLine(opts, name, sum))
+	}
+	return status
+}
+
+// parseArgs parses GNU-style arguments: bundled short options, long
+// options, "--" to end options and operands mixed in with options.
+func parseArgs(args []string) (*options, error) {
+	opts := new(options)
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		switch {
+		case arg == "--":
+			opts.files = append(opts.files, args[i+1:]...)
+			i = len(args)
+		case strings.HasPrefix(arg, "--"):
+			if err := opts.setLong(arg[2:]); err != nil {
+				return nil, err
+			}
+		case len(arg) > 1 && arg[0] == '-':
+			for _, c := range arg[1:] {
+				if err := opts.setShort(c); err != nil {
+					return nil, err
+				}
+			}
+		default:
+			opts.files = append(opts.files, arg)
+		}
+	}
+
+	switch {
+	case opts.check && opts.tag:
+		return nil, usageError("the --tag option is meaningless when verifying checksums")
+	case opts.check && (opts.binary || opts.text):
+		return nil, usageError("the --binary and --text options are meaningless when verifying checksums")
+	case opts.tag && opts.text:
+		return nil, usageError("--tag does not support --text mode")
+	}
+	if !opts.check {
+		for _, o := range []struct {
+			set  bool
+			name string
+		}{
+			{opts.ignoreMissing, "--ignore-missing"},
+			{opts.quiet, "--quiet"},
+			{opts.status, "--status"},
+			{opts.strict, "--strict"},
+			{opts.warn, "--warn"},
+		} {
+			if o.set {
+				return nil, usageError("the " + o.name + " option is meaningful only when verifying checksums")
+			}
+		}
+	}
+	return opts, nil
+}
+
+func (opts *options) setLong(name string) error {
+	switch name {
+	case "binary":
+		opts.binary, opts.text = true, false
+	case "text":
+		opts.binary, opts.text = false, true
+	case "tag":
+		opts.tag = true
+	case "zero":
+		opts.zero = true
+	case "help":
+		opts.help = true
+	case "check":
+		opts.check = true
+	case "ignore-missing":
+		opts.ignoreMissing = true
+	case "quiet":
+		opts.quiet = true
+	case "status":
+		opts.status = true
+	case "strict":
+		opts.strict = true
+	case "warn":
+		opts.warn = true
+	default:
+		return usageError(fmt.Sprintf("unrecognized option '--%s'", name))
+	}
+	return nil
+}
+
+func (opts *options) setShort(c rune) error {
+	switch c {
+	case 'b':
+		return opts.setLong("binary")
+	case 't':
+		return opts.setLong("text")
+	case 'z':
+		return opts.setLong("zero")
+	case 'c':
+		return opts.setLong("check")
+	case 'w':
+		return opts.setLong("warn")
+	}
+	return usageError(fmt.Sprintf("invalid option -- '%c'", c))
+}
+
+// sumFile hashes the named file, or stdin for "-".
+func sumFile(name string, stdin io.Reader) ([]byte, error) {
+	r := stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	sum, _, err := whirlpool.SumReader(r)
+	if err != nil {
+		return nil, err
+	}
+	return sum[:], nil
+}
+
+// formatLine returns the output line for one file.
+func formatLine(opts *options, name string, sum []byte) string {
+	// Names with special characters are escaped and the line is prefixed
+	// with a backslash, unless lines end with NUL.
+	prefix := ""
+	if !opts.zero && strings.ContainsAny(name, "\\\n\r") {
+		prefix = "\\"
+		name = escaper.Replace(name)
+	}
+	end := "\n"
+	if opts.zero {
+		end = "\x00"
+	}
+
+	if opts.tag {
+		return fmt.Sprintf("%sWHIRLPOOL (%s) = %x%s", prefix, name, sum, end)
+	}
+	mode := ' '
+	if opts.binary {
+		mode = '*'
+	}
+	return fmt.Sprintf("%s%x %c%s%s", prefix, sum, mode, name, end)
+}
+
+var escaper = strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\r", "\\r")
+
+// describe returns the message coreutils would print for err, such as
+// "No such file or directory".
+func describe(err error) string {
+	var pathErr *os.PathError
+	if errors.As(err, &pathErr) {
+		err = pathErr.Err
+	}
+	msg := err.Error()
+	if msg == "" {
+		return msg
+	}
+	return strings.ToUpper(msg[:1]) + msg[1:]
+}
@@ -0,0 +1,120 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	abcSum   = "4e2448a4c6f486bb16b6562c73b4020bf3043e3a731bce721ae1b303d97e6d4c7181eebdb6c57e277d0e34957114cbd6c797fc9d95d8b582d225292076d4eef5"
+	emptySum = "19fa61d75522a4669b44e39c1d2e1726c530232130d407f89afee0964997f7a73e83be698b288febcf88e3e03c4f0757ea8964e59b63d93708b138cc42a66eb3"
+)
+
+// writeFiles creates the named files in a temporary directory and returns
+// their paths in order.
+func writeFiles(t *testing.T, files ...string) []string {
+	dir := t.TempDir()
+	var paths []string
+	for i := 0; i < len(files); i += 2 {
+		path := filepath.Join(dir, files[i])
+		if err := os.WriteFile(path, []byte(files[i+1]), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+func runCommand(stdin string, args ...string) (status int, stdout, stderr string) {
+	var out, errOut bytes.Buffer
+	status = run(args, strings.NewReader(stdin), &out, &errOut)
+	return status, out.String(), errOut.String()
+}
+
+func TestSumFiles(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc", "empty", "")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{paths, abcSum + "  " + paths[0] + "\n" + emptySum + "  " + paths[1] + "\n"},
+		{append([]string{"-b"}, paths...), abcSum + " *" + paths[0] + "\n" + emptySum + " *" + paths[1] + "\n"},
+		{append([]string{"-bt"}, paths[0]), abcSum + "  " + paths[0] + "\n"},
+		{[]string{paths[0], "--binary"}, abcSum + " *" + paths[0] + "\n"},
+		{append([]string{"--tag"}, paths...), "WHIRLPOOL (" + paths[0] + ") = " + abcSum + "\nWHIRLPOOL (" + paths[1] + ") = " + emptySum + "\n"},
+		{append([]string{"--zero"}, paths...), abcSum + "  " + paths[0] + "\x00" + emptySum + "  " + paths[1] + "\x00"},
+		{append([]string{"--tag", "-z"}, paths[1]), "WHIRLPOOL (" + paths[1] + ") = " + emptySum + "\x00"},
+	}
+	for _, tt := range tests {
+		status, stdout, stderr := runCommand("", tt.args...)
+		if status != 0 || stderr != "" {
+			t.Errorf("whirlpoolsum %q exited %d: %s", tt.args, status, stderr)
+		}
+		if stdout != tt.want {
+			t.Errorf("whirlpoolsum %q printed %q want %q", tt.args, stdout, tt.want)
+		}
+	}
+}
+
+func TestSumStdin(t *testing.T) {
+	for _, args := range [][]string{nil, {"-"}, {"--", "-"}} {
+		status, stdout, _ := runCommand("abc", args...)
+		if want := abcSum + "  -\n"; status != 0 || stdout != want {
+			t.Errorf("whirlpoolsum %q = %d, %q want 0, %q", args, status, stdout, want)
+		}
+	}
+}
+
+func TestEscapedNames(t *testing.T) {
+	paths := writeFiles(t, "a\\b\nc", "abc")
+	escaped := strings.NewReplacer("\\", "\\\\", "\n", "\\n").Replace(paths[0])
+
+	_, stdout, _ := runCommand("", paths[0])
+	if want := "\\" + abcSum + "  " + escaped + "\n"; stdout != want {
+		t.Errorf("whirlpoolsum printed %q want %q", stdout, want)
+	}
+	_, stdout, _ = runCommand("", "--tag", paths[0])
+	if want := "\\WHIRLPOOL (" + escaped + ") = " + abcSum + "\n"; stdout != want {
+		t.Errorf("whirlpoolsum --tag printed %q want %q", stdout, want)
+	}
+	_, stdout, _ = runCommand("", "-z", paths[0])
+	if want := abcSum + "  " + paths[0] + "\x00"; stdout != want {
+		t.Errorf("whirlpoolsum -z printed %q want %q", stdout, want)
+	}
+}
+
+func TestExitStatus(t *testing.T) {
+	paths := writeFiles(t, "abc.txt", "abc")
+	missing := filepath.Join(filepath.Dir(paths[0]), "missing")
+
+	// Unreadable files are reported but the others are still hashed.
+	status, stdout, stderr := runCommand("", missing, paths[0])
+	if status != 1 {
+		t.Errorf("exit status %d want 1", status)
+	}
+	if want := "whirlpoolsum: " + missing + ": No such file or directory\n"; stderr != want {
+		t.Errorf("stderr %q want %q", stderr, want)
+	}
+	if want := abcSum + "  " + paths[0] + "\n"; stdout != want {
+		t.Errorf("stdout %q want %q", stdout, want)
+	}
+
+	for _, args := range [][]string{{"--bogus"}, {"-x"}, {"--tag", "--text"}} {
+		status, stdout, stderr := runCommand("", args...)
+		if status != 1 || stdout != "" || !strings.Contains(stderr, "Try 'whirlpoolsum --help'") {
+			t.Errorf("whirlpoolsum %q = %d, %q, %q", args, status, stdout, stderr)
+		}
+	}
+
+	if status, stdout, _ := runCommand("", "--help"); status != 0 || !strings.HasPrefix(stdout, "Usage:") {
+		t.Errorf("whirlpoolsum --help = %d, %q", status, stdout)
+	}
+}
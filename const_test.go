@@ -0,0 +1,155 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import "testing"
+
+// The mini-boxes from which the S-box of the final version is built.
+var (
+	miniE = [16]byte{0x1, 0xb, 0x9, 0xc, 0xd, 0x6, 0xf, 0x3, 0xe, 0x8, 0x7, 0x4, 0xa, 0x2, 0x5, 0x0}
+	miniR = [16]byte{0x7, 0xc, 0xb, 0xd, 0xe, 0x4, 0x9, 0xf, 0x6, 0x3, 0x8, 0xa, 0x2, 0x5, 0x1, 0x0}
+)
+
+// The first rows of the circulant diffusion matrices.
+var (
+	mdsFinal = [8]byte{1, 1, 4, 1, 8, 5, 2, 9}
+	mds0     = [8]byte{1, 1, 3, 1, 5, 8, 9, 5}
+)
+
+// gfMul multiplies a and b in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func gfMul(a, b byte) byte {
+	var p byte
+	for ; b != 0; b >>= 1 {
+		if b&1 != 0 {
+			p ^= a
+		}
+		if a&0x80 != 0 {
+			a = a<<1 ^ 0x1d
+		} else {
+			a <<= 1
+		}
+	}
+	return p
+}
+
+// sbox derives the S-box of the final version from the E, E^-1 and R
+// mini-boxes.
+func sbox() (S [256]byte) {
+	var einv [16]byte
+	for i, e := range miniE {
+		einv[e] = byte(i)
+	}
+	for u := range S {
+		a, b := miniE[u>>4], einv[u&0xf]
+		r := miniR[a^b]
+		S[u] = miniE[a^r]<<4 | einv[b^r]
+	}
+	return
+}
+
+// tables derives the lookup tables for an S-box and the first row of a
+// circulant matrix: C_t[x] is S[x] times row t of the matrix.
+func tables(S *[256]byte, m *[8]byte) (C [8][256]uint64) {
+	for t := range C {
+		for x := range C[t] {
+			for j := 0; j < 8; j++ {
+				C[t][x] = C[t][x]<<8 | uint64(gfMul(S[x], m[(j-t+8)%8]))
+			}
+		}
+	}
+	return
+}
+
+// roundConstants derives the round constants from an S-box: the key of
+// round r is seeded with the eight S-box entries starting at 8(r-1).
+func roundConstants(S *[256]byte) (c [rounds + 1]uint64) {
+	for r := 1; r <= rounds; r++ {
+		for j := 0; j < 8; j++ {
+			c[r] = c[r]<<8 | uint64(S[8*(r-1)+j])
+		}
+	}
+	return
+}
+
+func checkTables(t *testing.T, name string, got *[8][256]uint64, want *[8][256]uint64) {
+	for i := range want {
+		for x := range want[i] {
+			if got[i][x] != want[i][x] {
+				t.Fatalf("%s[%d][%#02x] = %#016x want %#016x", name, i, x, got[i][x], want[i][x])
+			}
+		}
+	}
+}
+
+// TestConst checks the tables of the final version against the S-box and
+// diffusion matrix of the specification.
+func TestConst(t *testing.T) {
+	S := sbox()
+	C := tables(&S, &mdsFinal)
+	checkTables(t, "_C", &[8][256]uint64{_C0, _C1, _C2, _C3, _C4, _C5, _C6, _C7}, &C)
+	if want := roundConstants(&S); rc != want {
+		t.Fatalf("rc = %#016x want %#016x", rc, want)
+	}
+}
+
+// TestConstT checks that Whirlpool-T pairs the final S-box with the matrix
+// of Whirlpool-0.
+func TestConstT(t *testing.T) {
+	S := sbox()
+	C := tables(&S, &mds0)
+	checkTables(t, "_WTC", &_WTC, &C)
+}
+
+// TestConst0 checks that the Whirlpool-0 tables are consistent with one
+// another. Its S-box was generated pseudo-randomly, so it is recovered from
+// the tables rather than derived.
+func TestConst0(t *testing.T) {
+	var S [256]byte
+	seen := make(map[byte]bool)
+	for x := range S {
+		S[x] = byte(_W0C[0][x] >> 56)
+		if seen[S[x]] {
+			t.Fatalf("Whirlpool-0 S-box is not a permutation: %#02x repeats", S[x])
+		}
+		seen[S[x]] = true
+	}
+	C := tables(&S, &mds0)
+	checkTables(t, "_W0C", &_W0C, &C)
+	if want := roundConstants(&S); _W0rc != want {
+		t.Fatalf("_W0rc = %#016x want %#016x", _W0rc, want)
+	}
+}
+
+// TestConstInv checks the decryption tables: _Sinv inverts the S-box, and
+// multiplying row x of _Cinv[t] by the diffusion matrix yields _Sinv[x] in
+// column t and zero elsewhere.
+func TestConstInv(t *testing.T) {
+	S := sbox()
+	for x := range S {
+		if _Sinv[S[x]] != byte(x) {
+			t.Fatalf("_Sinv[%#02x] = %#02x want %#02x", S[x], _Sinv[S[x]], x)
+		}
+	}
+	for i := range _Cinv {
+		for x := range _Cinv[i] {
+			var row [8]byte
+			for j := range row {
+				row[j] = byte(_Cinv[i][x] >> (56 - 8*uint(j)))
+			}
+			for j := 0; j < 8; j++ {
+				var p, want byte
+				for k := 0; k < 8; k++ {
+					p ^= gfMul(row[k], mdsFinal[(j-k+8)%8])
+				}
+				if j == i {
+					want = _Sinv[x]
+				}
+				if p != want {
+					t.Fatalf("_Cinv[%d][%#02x] times the matrix = %#02x in column %d want %#02x", i, x, p, j, want)
+				}
+			}
+		}
+	}
+}
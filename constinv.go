@@ -0,0 +1,559 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Lookup tables for decryption with the W block cipher. _Cinv combines the
+// inverse S-box with the inverse of the diffusion matrix
+// cir(1, 1, 4, 1, 8, 5, 2, 9).
+
+package whirlpool
+
+var _Cinv = [8][256]uint64{
+	{
+		0x3ea25d404b4bb648, 0x18c524ffb6b68084, 0x59054944b4b457c2, 0x4b5a76bcfafab725,
+		0xc610345d7a7a5475, 0xd8a319546f6ff4d0, 0x42fbe7c0ddddc7d8, 0x3175c5cacfcfe694,
+		0xf950e4348f8f19bc, 0xeb0fdbccc1c1f95b, 0xacb9bf8160600e3c, 0xb3e81fa1cbcb5518,
+		0x540bd69c5151ec01, 0x2b69e66718188d0f, 0xb20a92887575ae99, 0x996374ef6d6d2396,
+		0xc82521fe4040ff28, 0x0b78962e46469be2, 0xc184b08267678fd5, 0x738e220a1212214c,
+		0x9f157d19cece03b7, 0x8044dd3965655893, 0xe7e3c93d9a9ab919, 0x09a1917c272770fd,
+		0x0000000000000000, 0x498371ee9b9b5c3a, 0xa5182efd47477ec1, 0x266779bffdfd36cc,
+		0x5e91cd9ba9a98c62, 0x603390dbe2e23a2a, 0xd6960cf755555f8d, 0xb6a59c2cb7b765a7,
+		0xad5b32a8dedef5bd, 0xded510a2ccccd4f1, 0xd10288284848842d, 0x04af0ea4c2c2cb3e,
+		0x3befdecd373786f7, 0x921be2c12b2bb874, 0x699201a7c5c54ad7, 0xe4d843464545a987,
+		0xd77481deebeba40c, 0xa816b125a2a2c502, 0xa7c129af262695de, 0x36e14115d2d23d34,
+		0x7f6230fb4949610e, 0x3936d99f56566de8, 0x70b5a871cdcd31d2, 0x62ea97898383d135,
+		0x02d907526161eb1f, 0x87d059e678788333, 0x657e13569e9e0a95, 0x21f3fd60e0e0ed6c,
+		0x85095eb41919682c, 0x5c48cac9c8c8677d, 0x201170495e5e16ed, 0x8807c16cfcfcd3ef,
+		0xf228721ac9c9825e, 0x829dda6b0404b38c, 0xe34cc79958587227, 0x079484df1d1ddba0,
+		0x67a71404ffffe18a, 0xe195c0cb39399938, 0xd44f0ba53434b492, 0x7a2fb376353551b1,
+		0xfdffea904d4dd282, 0xe83451b71e1ee9c5, 0xc35db7d0060664ca, 0xcafc26ac21211437,
+		0x539f52434c4c37a1, 0x0a9a1b07f8f86063, 0x8b3c4b172323c371, 0x054d838d7c7c30bf,
+		0x81a65010dbdba312, 0x456f631fc0c01c78, 0x9881f9c6d3d3d817, 0x741aa6d50f0ffaec,
+		0x8632d4cfc6c678b2, 0x56d2d1ce3030071e, 0x13bdb2d1f0f01b66, 0x1c6a2a5b74744bba,
+		0x16f0315c8c8c2bd9, 0x2785f4964343cd4d, 0x3c7b5a122a2a5d57, 0xab2d3b5e7d7dd59c,
+		0xd941947dd1d10f51, 0x7e80bdd2f7f79a8f, 0x57305ce78e8efc9f, 0x7c59ba8096967190,
+		0xa055ad703b3b4e7e, 0x96b4ec65e9e9734a, 0x29b0e13579796610, 0x75f82bfcb1b1016d,
+		0xd3db8f7a29296f32, 0xc4c9330f1b1bbf6a, 0xfa6b6e4f50500922, 0xb13118f3aaaabe07,
+		0x4022e092bcbc2cc7, 0xe601441424244298, 0xaacfb677c3c32e1d, 0x5daa47e076769cfc,
+		0xcd68a2733c3ccf97, 0x6aa98bdc1a1a5a49, 0x1164b5839191f079, 0xdc0c17f0adad3fee,
+		0xa9f43c0c1c1c3e83, 0xefa0d56803033265, 0x125f3ff84e4ee0e7, 0xb890898f8d8dcefa,
+		0xbbab03f45252de64, 0x2fc6e8c3dada4631, 0x43196ae963633c59, 0x34384647b3b3d62b,
+		0xf765f197b5b5b2e1, 0x514655112d2ddcbe, 0x8e71c89a5f5ff3ce, 0xc52bbe26a5a544eb,
+		0x837f5742baba480d, 0x4e17f5318686879a, 0x2a8b6b4ea6a6768e, 0x84ebd39da7a793ad,
+		0xfec460eb9292c21c, 0x309748e371711d15, 0xb97204a63333357b, 0x58e7c46d0a0aac43,
+		0x15cbbb2753533b47, 0xf45e7bec6a6aa27f, 0x3f40d069f5f54dc9, 0x5f7340b2171777e3,
+		0x912068baf4f4a8ea, 0x63081aa03d3d2ab4, 0x2e2465ea6464bdb0, 0x255cf3c422222652,
+		0x8adec63e9d9d38f0, 0xbc3f872b4f4f05c4, 0xdb98932fb0b0e44e, 0x108638aa2f2f0bf8,
+		0x715725587373ca53, 0x93f96fe8959543f5, 0x4654e9641f1f0ce6, 0xec9b5f13dcdc22fb,
+		0xf6877cbe0b0b4960, 0xe53ace6ffbfb5206, 0x4ccef263e7e76c85, 0x1712bc753232d058,
+		0xb59e165768687539, 0x38d454b6e8e89669, 0x4ab8fb9544444ca4, 0xd5ad868c8a8a4f13,
+		0x6fe4085166666af6, 0xf8b2691d3131e23d, 0xa623a48698986e5f, 0x3a0d53e489897d76,
+		0xcfb1a5215d5d2488, 0x232afa3281810673, 0x6d3d0f03070781e9, 0x4861fcc72525a7bb,
+		0xd2390253979794b3, 0xbf040d509090155a, 0x01e28d29bebefb81, 0x90c2e5934a4a536b,
+		0xb47c9b7ed6d68eb8, 0xe9d6dc9ea0a01244, 0x726caf23acacdacd, 0x50a4d8389393273f,
+		0xcb1eab859f9fefb6, 0xee425841bdbdc9e4, 0x24be7eed9c9cddd3, 0xf0f17548a8a86941,
+		0xa28caa225a5aa561, 0x5bdc4e16d5d5bcdd, 0x55e95bb5efef1780, 0x033b8a7bdfdf109e,
+		0x9756614c575788cb, 0x2d1fef91bbbbad2e, 0xa1b720598585b5ff, 0x1eb32d091515a0a5,
+		0x61d11df25c5cc1ab, 0xb0d395da14144586, 0xba498eddecec25e5, 0xda7a1e060e0e1fcf,
+		0xddee9ad91313c46f, 0x1a1c23add7d76b9b, 0xf3caff33777779df, 0x1f51a020abab5b24,
+		0x1429360eededc0c6, 0x77212caed0d0ea72, 0x6e068578d8d89177, 0xa36e270be4e45ee0,
+		0x448dee367e7ee7f9, 0xc0663dabd9d97454, 0x8d4a42e18080e350, 0x35dacb6e0d0d2daa,
+		0x6645992d41411a0b, 0xaf8235fabfbf1ea2, 0x6cdf822ab9b97a68, 0xf5bcf6c5d4d459fe,
+		0x4ff5781838387c1b, 0x9ef7f0307070f836, 0x08431c5599998b7c, 0xce532808e3e3df09,
+		0x4d2c7f4a59599704, 0xa4faa3d4f9f98540, 0x3d99d73b9494a6d6, 0xd0e00501f6f67fac,
+		0xc7f2b974c4c4aff4, 0x89e54c454242286e, 0x0fd7988a848450dc, 0x324e4fb11010f60a,
+		0xff26edc22c2c399d, 0xeaed56e57f7f02da, 0x28526c1cc7c79d91, 0x7bcd3e5f8b8baa30,
+		0xae60b8d30101e523, 0x958f661e363663d4, 0x22c8771b3f3ffdf2, 0x68708c8e7b7bb156,
+		0xfc1d67b9f3f32903, 0x41c06dbb0202d746, 0x9c2ef76211111329, 0xed79d23a6262d97a,
+		0xb747110509099e26, 0x0d0e9fd8e5e5bbc3, 0x5a3ec33f6b6b475c, 0x33acc298aeae0d8b,
+		0x649c9e7f2020f114, 0x9bba73bd0c0cc889, 0xe2ae4ab0e6e689a6, 0x7914390deaea412f,
+		0xe0774de2878762b9, 0x8ca8cfc83e3e18d1, 0xbee680792e2eeedb, 0x47b6644da1a1f767,
+		0x0cec12f15b5b4042, 0x9dcc7a4bafafe8a8, 0x76c3a1876e6e11f3, 0x1bfeae846969901a,
+		0xf113f861161692c0, 0xfb89e366eeeef2a3, 0x1927a9d608087b05, 0x067609f6a3a32021,
+		0x946deb3788889855, 0xc9c7acd7fefe04a9, 0x7dbb37a928288a11, 0x0e3515a33a3aab5d,
+		0xcc8a2f5a82823416, 0x2cfd62b8050556af, 0x8f9345b3e1e1084f, 0x6b4b06f5a4a4a1c8,
+		0xdf379d8b72722f70, 0x9a58fe94b2b23308, 0x3703cc3c6c6cc6b5, 0x1d88a772cacab03b,
+		0xc2bf3af9b8b89f4b, 0x527ddf6af2f2cc20, 0x78f6b4245454baae, 0xbddd0a02f1f1fe45,
+	},
+	{
+		0x483ea25d404b4bb6, 0x8418c524ffb6b680, 0xc259054944b4b457, 0x254b5a76bcfafab7,
+		0x75c610345d7a7a54, 0xd0d8a319546f6ff4, 0xd842fbe7c0ddddc7, 0x943175c5cacfcfe6,
+		0xbcf950e4348f8f19, 0x5beb0fdbccc1c1f9, 0x3cacb9bf8160600e, 0x18b3e81fa1cbcb55,
+		0x01540bd69c5151ec, 0x0f2b69e66718188d, 0x99b20a92887575ae, 0x96996374ef6d6d23,
+		0x28c82521fe4040ff, 0xe20b78962e46469b, 0xd5c184b08267678f, 0x4c738e220a121221,
+		0xb79f157d19cece03, 0x938044dd39656558, 0x19e7e3c93d9a9ab9, 0xfd09a1917c272770,
+		0x0000000000000000, 0x3a498371ee9b9b5c, 0xc1a5182efd47477e, 0xcc266779bffdfd36,
+		0x625e91cd9ba9a98c, 0x2a603390dbe2e23a, 0x8dd6960cf755555f, 0xa7b6a59c2cb7b765,
+		0xbdad5b32a8dedef5, 0xf1ded510a2ccccd4, 0x2dd1028828484884, 0x3e04af0ea4c2c2cb,
+		0xf73befdecd373786, 0x74921be2c12b2bb8, 0xd7699201a7c5c54a, 0x87e4d843464545a9,
+		0x0cd77481deebeba4, 0x02a816b125a2a2c5, 0xdea7c129af262695, 0x3436e14115d2d23d,
+		0x0e7f6230fb494961, 0xe83936d99f56566d, 0xd270b5a871cdcd31, 0x3562ea97898383d1,
+		0x1f02d907526161eb, 0x3387d059e6787883, 0x95657e13569e9e0a, 0x6c21f3fd60e0e0ed,
+		0x2c85095eb4191968, 0x7d5c48cac9c8c867, 0xed201170495e5e16, 0xef8807c16cfcfcd3,
+		0x5ef228721ac9c982, 0x8c829dda6b0404b3, 0x27e34cc799585872, 0xa0079484df1d1ddb,
+		0x8a67a71404ffffe1, 0x38e195c0cb393999, 0x92d44f0ba53434b4, 0xb17a2fb376353551,
+		0x82fdffea904d4dd2, 0xc5e83451b71e1ee9, 0xcac35db7d0060664, 0x37cafc26ac212114,
+		0xa1539f52434c4c37, 0x630a9a1b07f8f860, 0x718b3c4b172323c3, 0xbf054d838d7c7c30,
+		0x1281a65010dbdba3, 0x78456f631fc0c01c, 0x179881f9c6d3d3d8, 0xec741aa6d50f0ffa,
+		0xb28632d4cfc6c678, 0x1e56d2d1ce303007, 0x6613bdb2d1f0f01b, 0xba1c6a2a5b74744b,
+		0xd916f0315c8c8c2b, 0x4d2785f4964343cd, 0x573c7b5a122a2a5d, 0x9cab2d3b5e7d7dd5,
+		0x51d941947dd1d10f, 0x8f7e80bdd2f7f79a, 0x9f57305ce78e8efc, 0x907c59ba80969671,
+		0x7ea055ad703b3b4e, 0x4a96b4ec65e9e973, 0x1029b0e135797966, 0x6d75f82bfcb1b101,
+		0x32d3db8f7a29296f, 0x6ac4c9330f1b1bbf, 0x22fa6b6e4f505009, 0x07b13118f3aaaabe,
+		0xc74022e092bcbc2c, 0x98e6014414242442, 0x1daacfb677c3c32e, 0xfc5daa47e076769c,
+		0x97cd68a2733c3ccf, 0x496aa98bdc1a1a5a, 0x791164b5839191f0, 0xeedc0c17f0adad3f,
+		0x83a9f43c0c1c1c3e, 0x65efa0d568030332, 0xe7125f3ff84e4ee0, 0xfab890898f8d8dce,
+		0x64bbab03f45252de, 0x312fc6e8c3dada46, 0x5943196ae963633c, 0x2b34384647b3b3d6,
+		0xe1f765f197b5b5b2, 0xbe514655112d2ddc, 0xce8e71c89a5f5ff3, 0xebc52bbe26a5a544,
+		0x0d837f5742baba48, 0x9a4e17f531868687, 0x8e2a8b6b4ea6a676, 0xad84ebd39da7a793,
+		0x1cfec460eb9292c2, 0x15309748e371711d, 0x7bb97204a6333335, 0x4358e7c46d0a0aac,
+		0x4715cbbb2753533b, 0x7ff45e7bec6a6aa2, 0xc93f40d069f5f54d, 0xe35f7340b2171777,
+		0xea912068baf4f4a8, 0xb463081aa03d3d2a, 0xb02e2465ea6464bd, 0x52255cf3c4222226,
+		0xf08adec63e9d9d38, 0xc4bc3f872b4f4f05, 0x4edb98932fb0b0e4, 0xf8108638aa2f2f0b,
+		0x53715725587373ca, 0xf593f96fe8959543, 0xe64654e9641f1f0c, 0xfbec9b5f13dcdc22,
+		0x60f6877cbe0b0b49, 0x06e53ace6ffbfb52, 0x854ccef263e7e76c, 0x581712bc753232d0,
+		0x39b59e1657686875, 0x6938d454b6e8e896, 0xa44ab8fb9544444c, 0x13d5ad868c8a8a4f,
+		0xf66fe4085166666a, 0x3df8b2691d3131e2, 0x5fa623a48698986e, 0x763a0d53e489897d,
+		0x88cfb1a5215d5d24, 0x73232afa32818106, 0xe96d3d0f03070781, 0xbb4861fcc72525a7,
+		0xb3d2390253979794, 0x5abf040d50909015, 0x8101e28d29bebefb, 0x6b90c2e5934a4a53,
+		0xb8b47c9b7ed6d68e, 0x44e9d6dc9ea0a012, 0xcd726caf23acacda, 0x3f50a4d838939327,
+		0xb6cb1eab859f9fef, 0xe4ee425841bdbdc9, 0xd324be7eed9c9cdd, 0x41f0f17548a8a869,
+		0x61a28caa225a5aa5, 0xdd5bdc4e16d5d5bc, 0x8055e95bb5efef17, 0x9e033b8a7bdfdf10,
+		0xcb9756614c575788, 0x2e2d1fef91bbbbad, 0xffa1b720598585b5, 0xa51eb32d091515a0,
+		0xab61d11df25c5cc1, 0x86b0d395da141445, 0xe5ba498eddecec25, 0xcfda7a1e060e0e1f,
+		0x6fddee9ad91313c4, 0x9b1a1c23add7d76b, 0xdff3caff33777779, 0x241f51a020abab5b,
+		0xc61429360eededc0, 0x7277212caed0d0ea, 0x776e068578d8d891, 0xe0a36e270be4e45e,
+		0xf9448dee367e7ee7, 0x54c0663dabd9d974, 0x508d4a42e18080e3, 0xaa35dacb6e0d0d2d,
+		0x0b6645992d41411a, 0xa2af8235fabfbf1e, 0x686cdf822ab9b97a, 0xfef5bcf6c5d4d459,
+		0x1b4ff5781838387c, 0x369ef7f0307070f8, 0x7c08431c5599998b, 0x09ce532808e3e3df,
+		0x044d2c7f4a595997, 0x40a4faa3d4f9f985, 0xd63d99d73b9494a6, 0xacd0e00501f6f67f,
+		0xf4c7f2b974c4c4af, 0x6e89e54c45424228, 0xdc0fd7988a848450, 0x0a324e4fb11010f6,
+		0x9dff26edc22c2c39, 0xdaeaed56e57f7f02, 0x9128526c1cc7c79d, 0x307bcd3e5f8b8baa,
+		0x23ae60b8d30101e5, 0xd4958f661e363663, 0xf222c8771b3f3ffd, 0x5668708c8e7b7bb1,
+		0x03fc1d67b9f3f329, 0x4641c06dbb0202d7, 0x299c2ef762111113, 0x7aed79d23a6262d9,
+		0x26b747110509099e, 0xc30d0e9fd8e5e5bb, 0x5c5a3ec33f6b6b47, 0x8b33acc298aeae0d,
+		0x14649c9e7f2020f1, 0x899bba73bd0c0cc8, 0xa6e2ae4ab0e6e689, 0x2f7914390deaea41,
+		0xb9e0774de2878762, 0xd18ca8cfc83e3e18, 0xdbbee680792e2eee, 0x6747b6644da1a1f7,
+		0x420cec12f15b5b40, 0xa89dcc7a4bafafe8, 0xf376c3a1876e6e11, 0x1a1bfeae84696990,
+		0xc0f113f861161692, 0xa3fb89e366eeeef2, 0x051927a9d608087b, 0x21067609f6a3a320,
+		0x55946deb37888898, 0xa9c9c7acd7fefe04, 0x117dbb37a928288a, 0x5d0e3515a33a3aab,
+		0x16cc8a2f5a828234, 0xaf2cfd62b8050556, 0x4f8f9345b3e1e108, 0xc86b4b06f5a4a4a1,
+		0x70df379d8b72722f, 0x089a58fe94b2b233, 0xb53703cc3c6c6cc6, 0x3b1d88a772cacab0,
+		0x4bc2bf3af9b8b89f, 0x20527ddf6af2f2cc, 0xae78f6b4245454ba, 0x45bddd0a02f1f1fe,
+	},
+	{
+		0xb6483ea25d404b4b, 0x808418c524ffb6b6, 0x57c259054944b4b4, 0xb7254b5a76bcfafa,
+		0x5475c610345d7a7a, 0xf4d0d8a319546f6f, 0xc7d842fbe7c0dddd, 0xe6943175c5cacfcf,
+		0x19bcf950e4348f8f, 0xf95beb0fdbccc1c1, 0x0e3cacb9bf816060, 0x5518b3e81fa1cbcb,
+		0xec01540bd69c5151, 0x8d0f2b69e6671818, 0xae99b20a92887575, 0x2396996374ef6d6d,
+		0xff28c82521fe4040, 0x9be20b78962e4646, 0x8fd5c184b0826767, 0x214c738e220a1212,
+		0x03b79f157d19cece, 0x58938044dd396565, 0xb919e7e3c93d9a9a, 0x70fd09a1917c2727,
+		0x0000000000000000, 0x5c3a498371ee9b9b, 0x7ec1a5182efd4747, 0x36cc266779bffdfd,
+		0x8c625e91cd9ba9a9, 0x3a2a603390dbe2e2, 0x5f8dd6960cf75555, 0x65a7b6a59c2cb7b7,
+		0xf5bdad5b32a8dede, 0xd4f1ded510a2cccc, 0x842dd10288284848, 0xcb3e04af0ea4c2c2,
+		0x86f73befdecd3737, 0xb874921be2c12b2b, 0x4ad7699201a7c5c5, 0xa987e4d843464545,
+		0xa40cd77481deebeb, 0xc502a816b125a2a2, 0x95dea7c129af2626, 0x3d3436e14115d2d2,
+		0x610e7f6230fb4949, 0x6de83936d99f5656, 0x31d270b5a871cdcd, 0xd13562ea97898383,
+		0xeb1f02d907526161, 0x833387d059e67878, 0x0a95657e13569e9e, 0xed6c21f3fd60e0e0,
+		0x682c85095eb41919, 0x677d5c48cac9c8c8, 0x16ed201170495e5e, 0xd3ef8807c16cfcfc,
+		0x825ef228721ac9c9, 0xb38c829dda6b0404, 0x7227e34cc7995858, 0xdba0079484df1d1d,
+		0xe18a67a71404ffff, 0x9938e195c0cb3939, 0xb492d44f0ba53434, 0x51b17a2fb3763535,
+		0xd282fdffea904d4d, 0xe9c5e83451b71e1e, 0x64cac35db7d00606, 0x1437cafc26ac2121,
+		0x37a1539f52434c4c, 0x60630a9a1b07f8f8, 0xc3718b3c4b172323, 0x30bf054d838d7c7c,
+		0xa31281a65010dbdb, 0x1c78456f631fc0c0, 0xd8179881f9c6d3d3, 0xfaec741aa6d50f0f,
+		0x78b28632d4cfc6c6, 0x071e56d2d1ce3030, 0x1b6613bdb2d1f0f0, 0x4bba1c6a2a5b7474,
+		0x2bd916f0315c8c8c, 0xcd4d2785f4964343, 0x5d573c7b5a122a2a, 0xd59cab2d3b5e7d7d,
+		0x0f51d941947dd1d1, 0x9a8f7e80bdd2f7f7, 0xfc9f57305ce78e8e, 0x71907c59ba809696,
+		0x4e7ea055ad703b3b, 0x734a96b4ec65e9e9, 0x661029b0e1357979, 0x016d75f82bfcb1b1,
+		0x6f32d3db8f7a2929, 0xbf6ac4c9330f1b1b, 0x0922fa6b6e4f5050, 0xbe07b13118f3aaaa,
+		0x2cc74022e092bcbc, 0x4298e60144142424, 0x2e1daacfb677c3c3, 0x9cfc5daa47e07676,
+		0xcf97cd68a2733c3c, 0x5a496aa98bdc1a1a, 0xf0791164b5839191, 0x3feedc0c17f0adad,
+		0x3e83a9f43c0c1c1c, 0x3265efa0d5680303, 0xe0e7125f3ff84e4e, 0xcefab890898f8d8d,
+		0xde64bbab03f45252, 0x46312fc6e8c3dada, 0x3c5943196ae96363, 0xd62b34384647b3b3,
+		0xb2e1f765f197b5b5, 0xdcbe514655112d2d, 0xf3ce8e71c89a5f5f, 0x44ebc52bbe26a5a5,
+		0x480d837f5742baba, 0x879a4e17f5318686, 0x768e2a8b6b4ea6a6, 0x93ad84ebd39da7a7,
+		0xc21cfec460eb9292, 0x1d15309748e37171, 0x357bb97204a63333, 0xac4358e7c46d0a0a,
+		0x3b4715cbbb275353, 0xa27ff45e7bec6a6a, 0x4dc93f40d069f5f5, 0x77e35f7340b21717,
+		0xa8ea912068baf4f4, 0x2ab463081aa03d3d, 0xbdb02e2465ea6464, 0x2652255cf3c42222,
+		0x38f08adec63e9d9d, 0x05c4bc3f872b4f4f, 0xe44edb98932fb0b0, 0x0bf8108638aa2f2f,
+		0xca53715725587373, 0x43f593f96fe89595, 0x0ce64654e9641f1f, 0x22fbec9b5f13dcdc,
+		0x4960f6877cbe0b0b, 0x5206e53ace6ffbfb, 0x6c854ccef263e7e7, 0xd0581712bc753232,
+		0x7539b59e16576868, 0x966938d454b6e8e8, 0x4ca44ab8fb954444, 0x4f13d5ad868c8a8a,
+		0x6af66fe408516666, 0xe23df8b2691d3131, 0x6e5fa623a4869898, 0x7d763a0d53e48989,
+		0x2488cfb1a5215d5d, 0x0673232afa328181, 0x81e96d3d0f030707, 0xa7bb4861fcc72525,
+		0x94b3d23902539797, 0x155abf040d509090, 0xfb8101e28d29bebe, 0x536b90c2e5934a4a,
+		0x8eb8b47c9b7ed6d6, 0x1244e9d6dc9ea0a0, 0xdacd726caf23acac, 0x273f50a4d8389393,
+		0xefb6cb1eab859f9f, 0xc9e4ee425841bdbd, 0xddd324be7eed9c9c, 0x6941f0f17548a8a8,
+		0xa561a28caa225a5a, 0xbcdd5bdc4e16d5d5, 0x178055e95bb5efef, 0x109e033b8a7bdfdf,
+		0x88cb9756614c5757, 0xad2e2d1fef91bbbb, 0xb5ffa1b720598585, 0xa0a51eb32d091515,
+		0xc1ab61d11df25c5c, 0x4586b0d395da1414, 0x25e5ba498eddecec, 0x1fcfda7a1e060e0e,
+		0xc46fddee9ad91313, 0x6b9b1a1c23add7d7, 0x79dff3caff337777, 0x5b241f51a020abab,
+		0xc0c61429360eeded, 0xea7277212caed0d0, 0x91776e068578d8d8, 0x5ee0a36e270be4e4,
+		0xe7f9448dee367e7e, 0x7454c0663dabd9d9, 0xe3508d4a42e18080, 0x2daa35dacb6e0d0d,
+		0x1a0b6645992d4141, 0x1ea2af8235fabfbf, 0x7a686cdf822ab9b9, 0x59fef5bcf6c5d4d4,
+		0x7c1b4ff578183838, 0xf8369ef7f0307070, 0x8b7c08431c559999, 0xdf09ce532808e3e3,
+		0x97044d2c7f4a5959, 0x8540a4faa3d4f9f9, 0xa6d63d99d73b9494, 0x7facd0e00501f6f6,
+		0xaff4c7f2b974c4c4, 0x286e89e54c454242, 0x50dc0fd7988a8484, 0xf60a324e4fb11010,
+		0x399dff26edc22c2c, 0x02daeaed56e57f7f, 0x9d9128526c1cc7c7, 0xaa307bcd3e5f8b8b,
+		0xe523ae60b8d30101, 0x63d4958f661e3636, 0xfdf222c8771b3f3f, 0xb15668708c8e7b7b,
+		0x2903fc1d67b9f3f3, 0xd74641c06dbb0202, 0x13299c2ef7621111, 0xd97aed79d23a6262,
+		0x9e26b74711050909, 0xbbc30d0e9fd8e5e5, 0x475c5a3ec33f6b6b, 0x0d8b33acc298aeae,
+		0xf114649c9e7f2020, 0xc8899bba73bd0c0c, 0x89a6e2ae4ab0e6e6, 0x412f7914390deaea,
+		0x62b9e0774de28787, 0x18d18ca8cfc83e3e, 0xeedbbee680792e2e, 0xf76747b6644da1a1,
+		0x40420cec12f15b5b, 0xe8a89dcc7a4bafaf, 0x11f376c3a1876e6e, 0x901a1bfeae846969,
+		0x92c0f113f8611616, 0xf2a3fb89e366eeee, 0x7b051927a9d60808, 0x2021067609f6a3a3,
+		0x9855946deb378888, 0x04a9c9c7acd7fefe, 0x8a117dbb37a92828, 0xab5d0e3515a33a3a,
+		0x3416cc8a2f5a8282, 0x56af2cfd62b80505, 0x084f8f9345b3e1e1, 0xa1c86b4b06f5a4a4,
+		0x2f70df379d8b7272, 0x33089a58fe94b2b2, 0xc6b53703cc3c6c6c, 0xb03b1d88a772caca,
+		0x9f4bc2bf3af9b8b8, 0xcc20527ddf6af2f2, 0xbaae78f6b4245454, 0xfe45bddd0a02f1f1,
+	},
+	{
+		0x4bb6483ea25d404b, 0xb6808418c524ffb6, 0xb457c259054944b4, 0xfab7254b5a76bcfa,
+		0x7a5475c610345d7a, 0x6ff4d0d8a319546f, 0xddc7d842fbe7c0dd, 0xcfe6943175c5cacf,
+		0x8f19bcf950e4348f, 0xc1f95beb0fdbccc1, 0x600e3cacb9bf8160, 0xcb5518b3e81fa1cb,
+		0x51ec01540bd69c51, 0x188d0f2b69e66718, 0x75ae99b20a928875, 0x6d2396996374ef6d,
+		0x40ff28c82521fe40, 0x469be20b78962e46, 0x678fd5c184b08267, 0x12214c738e220a12,
+		0xce03b79f157d19ce, 0x6558938044dd3965, 0x9ab919e7e3c93d9a, 0x2770fd09a1917c27,
+		0x0000000000000000, 0x9b5c3a498371ee9b, 0x477ec1a5182efd47, 0xfd36cc266779bffd,
+		0xa98c625e91cd9ba9, 0xe23a2a603390dbe2, 0x555f8dd6960cf755, 0xb765a7b6a59c2cb7,
+		0xdef5bdad5b32a8de, 0xccd4f1ded510a2cc, 0x48842dd102882848, 0xc2cb3e04af0ea4c2,
+		0x3786f73befdecd37, 0x2bb874921be2c12b, 0xc54ad7699201a7c5, 0x45a987e4d8434645,
+		0xeba40cd77481deeb, 0xa2c502a816b125a2, 0x2695dea7c129af26, 0xd23d3436e14115d2,
+		0x49610e7f6230fb49, 0x566de83936d99f56, 0xcd31d270b5a871cd, 0x83d13562ea978983,
+		0x61eb1f02d9075261, 0x78833387d059e678, 0x9e0a95657e13569e, 0xe0ed6c21f3fd60e0,
+		0x19682c85095eb419, 0xc8677d5c48cac9c8, 0x5e16ed201170495e, 0xfcd3ef8807c16cfc,
+		0xc9825ef228721ac9, 0x04b38c829dda6b04, 0x587227e34cc79958, 0x1ddba0079484df1d,
+		0xffe18a67a71404ff, 0x399938e195c0cb39, 0x34b492d44f0ba534, 0x3551b17a2fb37635,
+		0x4dd282fdffea904d, 0x1ee9c5e83451b71e, 0x0664cac35db7d006, 0x211437cafc26ac21,
+		0x4c37a1539f52434c, 0xf860630a9a1b07f8, 0x23c3718b3c4b1723, 0x7c30bf054d838d7c,
+		0xdba31281a65010db, 0xc01c78456f631fc0, 0xd3d8179881f9c6d3, 0x0ffaec741aa6d50f,
+		0xc678b28632d4cfc6, 0x30071e56d2d1ce30, 0xf01b6613bdb2d1f0, 0x744bba1c6a2a5b74,
+		0x8c2bd916f0315c8c, 0x43cd4d2785f49643, 0x2a5d573c7b5a122a, 0x7dd59cab2d3b5e7d,
+		0xd10f51d941947dd1, 0xf79a8f7e80bdd2f7, 0x8efc9f57305ce78e, 0x9671907c59ba8096,
+		0x3b4e7ea055ad703b, 0xe9734a96b4ec65e9, 0x79661029b0e13579, 0xb1016d75f82bfcb1,
+		0x296f32d3db8f7a29, 0x1bbf6ac4c9330f1b, 0x500922fa6b6e4f50, 0xaabe07b13118f3aa,
+		0xbc2cc74022e092bc, 0x244298e601441424, 0xc32e1daacfb677c3, 0x769cfc5daa47e076,
+		0x3ccf97cd68a2733c, 0x1a5a496aa98bdc1a, 0x91f0791164b58391, 0xad3feedc0c17f0ad,
+		0x1c3e83a9f43c0c1c, 0x033265efa0d56803, 0x4ee0e7125f3ff84e, 0x8dcefab890898f8d,
+		0x52de64bbab03f452, 0xda46312fc6e8c3da, 0x633c5943196ae963, 0xb3d62b34384647b3,
+		0xb5b2e1f765f197b5, 0x2ddcbe514655112d, 0x5ff3ce8e71c89a5f, 0xa544ebc52bbe26a5,
+		0xba480d837f5742ba, 0x86879a4e17f53186, 0xa6768e2a8b6b4ea6, 0xa793ad84ebd39da7,
+		0x92c21cfec460eb92, 0x711d15309748e371, 0x33357bb97204a633, 0x0aac4358e7c46d0a,
+		0x533b4715cbbb2753, 0x6aa27ff45e7bec6a, 0xf54dc93f40d069f5, 0x1777e35f7340b217,
+		0xf4a8ea912068baf4, 0x3d2ab463081aa03d, 0x64bdb02e2465ea64, 0x222652255cf3c422,
+		0x9d38f08adec63e9d, 0x4f05c4bc3f872b4f, 0xb0e44edb98932fb0, 0x2f0bf8108638aa2f,
+		0x73ca537157255873, 0x9543f593f96fe895, 0x1f0ce64654e9641f, 0xdc22fbec9b5f13dc,
+		0x0b4960f6877cbe0b, 0xfb5206e53ace6ffb, 0xe76c854ccef263e7, 0x32d0581712bc7532,
+		0x687539b59e165768, 0xe8966938d454b6e8, 0x444ca44ab8fb9544, 0x8a4f13d5ad868c8a,
+		0x666af66fe4085166, 0x31e23df8b2691d31, 0x986e5fa623a48698, 0x897d763a0d53e489,
+		0x5d2488cfb1a5215d, 0x810673232afa3281, 0x0781e96d3d0f0307, 0x25a7bb4861fcc725,
+		0x9794b3d239025397, 0x90155abf040d5090, 0xbefb8101e28d29be, 0x4a536b90c2e5934a,
+		0xd68eb8b47c9b7ed6, 0xa01244e9d6dc9ea0, 0xacdacd726caf23ac, 0x93273f50a4d83893,
+		0x9fefb6cb1eab859f, 0xbdc9e4ee425841bd, 0x9cddd324be7eed9c, 0xa86941f0f17548a8,
+		0x5aa561a28caa225a, 0xd5bcdd5bdc4e16d5, 0xef178055e95bb5ef, 0xdf109e033b8a7bdf,
+		0x5788cb9756614c57, 0xbbad2e2d1fef91bb, 0x85b5ffa1b7205985, 0x15a0a51eb32d0915,
+		0x5cc1ab61d11df25c, 0x144586b0d395da14, 0xec25e5ba498eddec, 0x0e1fcfda7a1e060e,
+		0x13c46fddee9ad913, 0xd76b9b1a1c23add7, 0x7779dff3caff3377, 0xab5b241f51a020ab,
+		0xedc0c61429360eed, 0xd0ea7277212caed0, 0xd891776e068578d8, 0xe45ee0a36e270be4,
+		0x7ee7f9448dee367e, 0xd97454c0663dabd9, 0x80e3508d4a42e180, 0x0d2daa35dacb6e0d,
+		0x411a0b6645992d41, 0xbf1ea2af8235fabf, 0xb97a686cdf822ab9, 0xd459fef5bcf6c5d4,
+		0x387c1b4ff5781838, 0x70f8369ef7f03070, 0x998b7c08431c5599, 0xe3df09ce532808e3,
+		0x5997044d2c7f4a59, 0xf98540a4faa3d4f9, 0x94a6d63d99d73b94, 0xf67facd0e00501f6,
+		0xc4aff4c7f2b974c4, 0x42286e89e54c4542, 0x8450dc0fd7988a84, 0x10f60a324e4fb110,
+		0x2c399dff26edc22c, 0x7f02daeaed56e57f, 0xc79d9128526c1cc7, 0x8baa307bcd3e5f8b,
+		0x01e523ae60b8d301, 0x3663d4958f661e36, 0x3ffdf222c8771b3f, 0x7bb15668708c8e7b,
+		0xf32903fc1d67b9f3, 0x02d74641c06dbb02, 0x1113299c2ef76211, 0x62d97aed79d23a62,
+		0x099e26b747110509, 0xe5bbc30d0e9fd8e5, 0x6b475c5a3ec33f6b, 0xae0d8b33acc298ae,
+		0x20f114649c9e7f20, 0x0cc8899bba73bd0c, 0xe689a6e2ae4ab0e6, 0xea412f7914390dea,
+		0x8762b9e0774de287, 0x3e18d18ca8cfc83e, 0x2eeedbbee680792e, 0xa1f76747b6644da1,
+		0x5b40420cec12f15b, 0xafe8a89dcc7a4baf, 0x6e11f376c3a1876e, 0x69901a1bfeae8469,
+		0x1692c0f113f86116, 0xeef2a3fb89e366ee, 0x087b051927a9d608, 0xa32021067609f6a3,
+		0x889855946deb3788, 0xfe04a9c9c7acd7fe, 0x288a117dbb37a928, 0x3aab5d0e3515a33a,
+		0x823416cc8a2f5a82, 0x0556af2cfd62b805, 0xe1084f8f9345b3e1, 0xa4a1c86b4b06f5a4,
+		0x722f70df379d8b72, 0xb233089a58fe94b2, 0x6cc6b53703cc3c6c, 0xcab03b1d88a772ca,
+		0xb89f4bc2bf3af9b8, 0xf2cc20527ddf6af2, 0x54baae78f6b42454, 0xf1fe45bddd0a02f1,
+	},
+	{
+		0x4b4bb6483ea25d40, 0xb6b6808418c524ff, 0xb4b457c259054944, 0xfafab7254b5a76bc,
+		0x7a7a5475c610345d, 0x6f6ff4d0d8a31954, 0xddddc7d842fbe7c0, 0xcfcfe6943175c5ca,
+		0x8f8f19bcf950e434, 0xc1c1f95beb0fdbcc, 0x60600e3cacb9bf81, 0xcbcb5518b3e81fa1,
+		0x5151ec01540bd69c, 0x18188d0f2b69e667, 0x7575ae99b20a9288, 0x6d6d2396996374ef,
+		0x4040ff28c82521fe, 0x46469be20b78962e, 0x67678fd5c184b082, 0x1212214c738e220a,
+		0xcece03b79f157d19, 0x656558938044dd39, 0x9a9ab919e7e3c93d, 0x272770fd09a1917c,
+		0x0000000000000000, 0x9b9b5c3a498371ee, 0x47477ec1a5182efd, 0xfdfd36cc266779bf,
+		0xa9a98c625e91cd9b, 0xe2e23a2a603390db, 0x55555f8dd6960cf7, 0xb7b765a7b6a59c2c,
+		0xdedef5bdad5b32a8, 0xccccd4f1ded510a2, 0x4848842dd1028828, 0xc2c2cb3e04af0ea4,
+		0x373786f73befdecd, 0x2b2bb874921be2c1, 0xc5c54ad7699201a7, 0x4545a987e4d84346,
+		0xebeba40cd77481de, 0xa2a2c502a816b125, 0x262695dea7c129af, 0xd2d23d3436e14115,
+		0x4949610e7f6230fb, 0x56566de83936d99f, 0xcdcd31d270b5a871, 0x8383d13562ea9789,
+		0x6161eb1f02d90752, 0x7878833387d059e6, 0x9e9e0a95657e1356, 0xe0e0ed6c21f3fd60,
+		0x1919682c85095eb4, 0xc8c8677d5c48cac9, 0x5e5e16ed20117049, 0xfcfcd3ef8807c16c,
+		0xc9c9825ef228721a, 0x0404b38c829dda6b, 0x58587227e34cc799, 0x1d1ddba0079484df,
+		0xffffe18a67a71404, 0x39399938e195c0cb, 0x3434b492d44f0ba5, 0x353551b17a2fb376,
+		0x4d4dd282fdffea90, 0x1e1ee9c5e83451b7, 0x060664cac35db7d0, 0x21211437cafc26ac,
+		0x4c4c37a1539f5243, 0xf8f860630a9a1b07, 0x2323c3718b3c4b17, 0x7c7c30bf054d838d,
+		0xdbdba31281a65010, 0xc0c01c78456f631f, 0xd3d3d8179881f9c6, 0x0f0ffaec741aa6d5,
+		0xc6c678b28632d4cf, 0x3030071e56d2d1ce, 0xf0f01b6613bdb2d1, 0x74744bba1c6a2a5b,
+		0x8c8c2bd916f0315c, 0x4343cd4d2785f496, 0x2a2a5d573c7b5a12, 0x7d7dd59cab2d3b5e,
+		0xd1d10f51d941947d, 0xf7f79a8f7e80bdd2, 0x8e8efc9f57305ce7, 0x969671907c59ba80,
+		0x3b3b4e7ea055ad70, 0xe9e9734a96b4ec65, 0x7979661029b0e135, 0xb1b1016d75f82bfc,
+		0x29296f32d3db8f7a, 0x1b1bbf6ac4c9330f, 0x50500922fa6b6e4f, 0xaaaabe07b13118f3,
+		0xbcbc2cc74022e092, 0x24244298e6014414, 0xc3c32e1daacfb677, 0x76769cfc5daa47e0,
+		0x3c3ccf97cd68a273, 0x1a1a5a496aa98bdc, 0x9191f0791164b583, 0xadad3feedc0c17f0,
+		0x1c1c3e83a9f43c0c, 0x03033265efa0d568, 0x4e4ee0e7125f3ff8, 0x8d8dcefab890898f,
+		0x5252de64bbab03f4, 0xdada46312fc6e8c3, 0x63633c5943196ae9, 0xb3b3d62b34384647,
+		0xb5b5b2e1f765f197, 0x2d2ddcbe51465511, 0x5f5ff3ce8e71c89a, 0xa5a544ebc52bbe26,
+		0xbaba480d837f5742, 0x8686879a4e17f531, 0xa6a6768e2a8b6b4e, 0xa7a793ad84ebd39d,
+		0x9292c21cfec460eb, 0x71711d15309748e3, 0x3333357bb97204a6, 0x0a0aac4358e7c46d,
+		0x53533b4715cbbb27, 0x6a6aa27ff45e7bec, 0xf5f54dc93f40d069, 0x171777e35f7340b2,
+		0xf4f4a8ea912068ba, 0x3d3d2ab463081aa0, 0x6464bdb02e2465ea, 0x22222652255cf3c4,
+		0x9d9d38f08adec63e, 0x4f4f05c4bc3f872b, 0xb0b0e44edb98932f, 0x2f2f0bf8108638aa,
+		0x7373ca5371572558, 0x959543f593f96fe8, 0x1f1f0ce64654e964, 0xdcdc22fbec9b5f13,
+		0x0b0b4960f6877cbe, 0xfbfb5206e53ace6f, 0xe7e76c854ccef263, 0x3232d0581712bc75,
+		0x68687539b59e1657, 0xe8e8966938d454b6, 0x44444ca44ab8fb95, 0x8a8a4f13d5ad868c,
+		0x66666af66fe40851, 0x3131e23df8b2691d, 0x98986e5fa623a486, 0x89897d763a0d53e4,
+		0x5d5d2488cfb1a521, 0x81810673232afa32, 0x070781e96d3d0f03, 0x2525a7bb4861fcc7,
+		0x979794b3d2390253, 0x9090155abf040d50, 0xbebefb8101e28d29, 0x4a4a536b90c2e593,
+		0xd6d68eb8b47c9b7e, 0xa0a01244e9d6dc9e, 0xacacdacd726caf23, 0x9393273f50a4d838,
+		0x9f9fefb6cb1eab85, 0xbdbdc9e4ee425841, 0x9c9cddd324be7eed, 0xa8a86941f0f17548,
+		0x5a5aa561a28caa22, 0xd5d5bcdd5bdc4e16, 0xefef178055e95bb5, 0xdfdf109e033b8a7b,
+		0x575788cb9756614c, 0xbbbbad2e2d1fef91, 0x8585b5ffa1b72059, 0x1515a0a51eb32d09,
+		0x5c5cc1ab61d11df2, 0x14144586b0d395da, 0xecec25e5ba498edd, 0x0e0e1fcfda7a1e06,
+		0x1313c46fddee9ad9, 0xd7d76b9b1a1c23ad, 0x777779dff3caff33, 0xabab5b241f51a020,
+		0xededc0c61429360e, 0xd0d0ea7277212cae, 0xd8d891776e068578, 0xe4e45ee0a36e270b,
+		0x7e7ee7f9448dee36, 0xd9d97454c0663dab, 0x8080e3508d4a42e1, 0x0d0d2daa35dacb6e,
+		0x41411a0b6645992d, 0xbfbf1ea2af8235fa, 0xb9b97a686cdf822a, 0xd4d459fef5bcf6c5,
+		0x38387c1b4ff57818, 0x7070f8369ef7f030, 0x99998b7c08431c55, 0xe3e3df09ce532808,
+		0x595997044d2c7f4a, 0xf9f98540a4faa3d4, 0x9494a6d63d99d73b, 0xf6f67facd0e00501,
+		0xc4c4aff4c7f2b974, 0x4242286e89e54c45, 0x848450dc0fd7988a, 0x1010f60a324e4fb1,
+		0x2c2c399dff26edc2, 0x7f7f02daeaed56e5, 0xc7c79d9128526c1c, 0x8b8baa307bcd3e5f,
+		0x0101e523ae60b8d3, 0x363663d4958f661e, 0x3f3ffdf222c8771b, 0x7b7bb15668708c8e,
+		0xf3f32903fc1d67b9, 0x0202d74641c06dbb, 0x111113299c2ef762, 0x6262d97aed79d23a,
+		0x09099e26b7471105, 0xe5e5bbc30d0e9fd8, 0x6b6b475c5a3ec33f, 0xaeae0d8b33acc298,
+		0x2020f114649c9e7f, 0x0c0cc8899bba73bd, 0xe6e689a6e2ae4ab0, 0xeaea412f7914390d,
+		0x878762b9e0774de2, 0x3e3e18d18ca8cfc8, 0x2e2eeedbbee68079, 0xa1a1f76747b6644d,
+		0x5b5b40420cec12f1, 0xafafe8a89dcc7a4b, 0x6e6e11f376c3a187, 0x6969901a1bfeae84,
+		0x161692c0f113f861, 0xeeeef2a3fb89e366, 0x08087b051927a9d6, 0xa3a32021067609f6,
+		0x88889855946deb37, 0xfefe04a9c9c7acd7, 0x28288a117dbb37a9, 0x3a3aab5d0e3515a3,
+		0x82823416cc8a2f5a, 0x050556af2cfd62b8, 0xe1e1084f8f9345b3, 0xa4a4a1c86b4b06f5,
+		0x72722f70df379d8b, 0xb2b233089a58fe94, 0x6c6cc6b53703cc3c, 0xcacab03b1d88a772,
+		0xb8b89f4bc2bf3af9, 0xf2f2cc20527ddf6a, 0x5454baae78f6b424, 0xf1f1fe45bddd0a02,
+	},
+	{
+		0x404b4bb6483ea25d, 0xffb6b6808418c524, 0x44b4b457c2590549, 0xbcfafab7254b5a76,
+		0x5d7a7a5475c61034, 0x546f6ff4d0d8a319, 0xc0ddddc7d842fbe7, 0xcacfcfe6943175c5,
+		0x348f8f19bcf950e4, 0xccc1c1f95beb0fdb, 0x8160600e3cacb9bf, 0xa1cbcb5518b3e81f,
+		0x9c5151ec01540bd6, 0x6718188d0f2b69e6, 0x887575ae99b20a92, 0xef6d6d2396996374,
+		0xfe4040ff28c82521, 0x2e46469be20b7896, 0x8267678fd5c184b0, 0x0a1212214c738e22,
+		0x19cece03b79f157d, 0x39656558938044dd, 0x3d9a9ab919e7e3c9, 0x7c272770fd09a191,
+		0x0000000000000000, 0xee9b9b5c3a498371, 0xfd47477ec1a5182e, 0xbffdfd36cc266779,
+		0x9ba9a98c625e91cd, 0xdbe2e23a2a603390, 0xf755555f8dd6960c, 0x2cb7b765a7b6a59c,
+		0xa8dedef5bdad5b32, 0xa2ccccd4f1ded510, 0x284848842dd10288, 0xa4c2c2cb3e04af0e,
+		0xcd373786f73befde, 0xc12b2bb874921be2, 0xa7c5c54ad7699201, 0x464545a987e4d843,
+		0xdeebeba40cd77481, 0x25a2a2c502a816b1, 0xaf262695dea7c129, 0x15d2d23d3436e141,
+		0xfb4949610e7f6230, 0x9f56566de83936d9, 0x71cdcd31d270b5a8, 0x898383d13562ea97,
+		0x526161eb1f02d907, 0xe67878833387d059, 0x569e9e0a95657e13, 0x60e0e0ed6c21f3fd,
+		0xb41919682c85095e, 0xc9c8c8677d5c48ca, 0x495e5e16ed201170, 0x6cfcfcd3ef8807c1,
+		0x1ac9c9825ef22872, 0x6b0404b38c829dda, 0x9958587227e34cc7, 0xdf1d1ddba0079484,
+		0x04ffffe18a67a714, 0xcb39399938e195c0, 0xa53434b492d44f0b, 0x76353551b17a2fb3,
+		0x904d4dd282fdffea, 0xb71e1ee9c5e83451, 0xd0060664cac35db7, 0xac21211437cafc26,
+		0x434c4c37a1539f52, 0x07f8f860630a9a1b, 0x172323c3718b3c4b, 0x8d7c7c30bf054d83,
+		0x10dbdba31281a650, 0x1fc0c01c78456f63, 0xc6d3d3d8179881f9, 0xd50f0ffaec741aa6,
+		0xcfc6c678b28632d4, 0xce3030071e56d2d1, 0xd1f0f01b6613bdb2, 0x5b74744bba1c6a2a,
+		0x5c8c8c2bd916f031, 0x964343cd4d2785f4, 0x122a2a5d573c7b5a, 0x5e7d7dd59cab2d3b,
+		0x7dd1d10f51d94194, 0xd2f7f79a8f7e80bd, 0xe78e8efc9f57305c, 0x80969671907c59ba,
+		0x703b3b4e7ea055ad, 0x65e9e9734a96b4ec, 0x357979661029b0e1, 0xfcb1b1016d75f82b,
+		0x7a29296f32d3db8f, 0x0f1b1bbf6ac4c933, 0x4f50500922fa6b6e, 0xf3aaaabe07b13118,
+		0x92bcbc2cc74022e0, 0x1424244298e60144, 0x77c3c32e1daacfb6, 0xe076769cfc5daa47,
+		0x733c3ccf97cd68a2, 0xdc1a1a5a496aa98b, 0x839191f0791164b5, 0xf0adad3feedc0c17,
+		0x0c1c1c3e83a9f43c, 0x6803033265efa0d5, 0xf84e4ee0e7125f3f, 0x8f8d8dcefab89089,
+		0xf45252de64bbab03, 0xc3dada46312fc6e8, 0xe963633c5943196a, 0x47b3b3d62b343846,
+		0x97b5b5b2e1f765f1, 0x112d2ddcbe514655, 0x9a5f5ff3ce8e71c8, 0x26a5a544ebc52bbe,
+		0x42baba480d837f57, 0x318686879a4e17f5, 0x4ea6a6768e2a8b6b, 0x9da7a793ad84ebd3,
+		0xeb9292c21cfec460, 0xe371711d15309748, 0xa63333357bb97204, 0x6d0a0aac4358e7c4,
+		0x2753533b4715cbbb, 0xec6a6aa27ff45e7b, 0x69f5f54dc93f40d0, 0xb2171777e35f7340,
+		0xbaf4f4a8ea912068, 0xa03d3d2ab463081a, 0xea6464bdb02e2465, 0xc422222652255cf3,
+		0x3e9d9d38f08adec6, 0x2b4f4f05c4bc3f87, 0x2fb0b0e44edb9893, 0xaa2f2f0bf8108638,
+		0x587373ca53715725, 0xe8959543f593f96f, 0x641f1f0ce64654e9, 0x13dcdc22fbec9b5f,
+		0xbe0b0b4960f6877c, 0x6ffbfb5206e53ace, 0x63e7e76c854ccef2, 0x753232d0581712bc,
+		0x5768687539b59e16, 0xb6e8e8966938d454, 0x9544444ca44ab8fb, 0x8c8a8a4f13d5ad86,
+		0x5166666af66fe408, 0x1d3131e23df8b269, 0x8698986e5fa623a4, 0xe489897d763a0d53,
+		0x215d5d2488cfb1a5, 0x3281810673232afa, 0x03070781e96d3d0f, 0xc72525a7bb4861fc,
+		0x53979794b3d23902, 0x509090155abf040d, 0x29bebefb8101e28d, 0x934a4a536b90c2e5,
+		0x7ed6d68eb8b47c9b, 0x9ea0a01244e9d6dc, 0x23acacdacd726caf, 0x389393273f50a4d8,
+		0x859f9fefb6cb1eab, 0x41bdbdc9e4ee4258, 0xed9c9cddd324be7e, 0x48a8a86941f0f175,
+		0x225a5aa561a28caa, 0x16d5d5bcdd5bdc4e, 0xb5efef178055e95b, 0x7bdfdf109e033b8a,
+		0x4c575788cb975661, 0x91bbbbad2e2d1fef, 0x598585b5ffa1b720, 0x091515a0a51eb32d,
+		0xf25c5cc1ab61d11d, 0xda14144586b0d395, 0xddecec25e5ba498e, 0x060e0e1fcfda7a1e,
+		0xd91313c46fddee9a, 0xadd7d76b9b1a1c23, 0x33777779dff3caff, 0x20abab5b241f51a0,
+		0x0eededc0c6142936, 0xaed0d0ea7277212c, 0x78d8d891776e0685, 0x0be4e45ee0a36e27,
+		0x367e7ee7f9448dee, 0xabd9d97454c0663d, 0xe18080e3508d4a42, 0x6e0d0d2daa35dacb,
+		0x2d41411a0b664599, 0xfabfbf1ea2af8235, 0x2ab9b97a686cdf82, 0xc5d4d459fef5bcf6,
+		0x1838387c1b4ff578, 0x307070f8369ef7f0, 0x5599998b7c08431c, 0x08e3e3df09ce5328,
+		0x4a595997044d2c7f, 0xd4f9f98540a4faa3, 0x3b9494a6d63d99d7, 0x01f6f67facd0e005,
+		0x74c4c4aff4c7f2b9, 0x454242286e89e54c, 0x8a848450dc0fd798, 0xb11010f60a324e4f,
+		0xc22c2c399dff26ed, 0xe57f7f02daeaed56, 0x1cc7c79d9128526c, 0x5f8b8baa307bcd3e,
+		0xd30101e523ae60b8, 0x1e363663d4958f66, 0x1b3f3ffdf222c877, 0x8e7b7bb15668708c,
+		0xb9f3f32903fc1d67, 0xbb0202d74641c06d, 0x62111113299c2ef7, 0x3a6262d97aed79d2,
+		0x0509099e26b74711, 0xd8e5e5bbc30d0e9f, 0x3f6b6b475c5a3ec3, 0x98aeae0d8b33acc2,
+		0x7f2020f114649c9e, 0xbd0c0cc8899bba73, 0xb0e6e689a6e2ae4a, 0x0deaea412f791439,
+		0xe2878762b9e0774d, 0xc83e3e18d18ca8cf, 0x792e2eeedbbee680, 0x4da1a1f76747b664,
+		0xf15b5b40420cec12, 0x4bafafe8a89dcc7a, 0x876e6e11f376c3a1, 0x846969901a1bfeae,
+		0x61161692c0f113f8, 0x66eeeef2a3fb89e3, 0xd608087b051927a9, 0xf6a3a32021067609,
+		0x3788889855946deb, 0xd7fefe04a9c9c7ac, 0xa928288a117dbb37, 0xa33a3aab5d0e3515,
+		0x5a82823416cc8a2f, 0xb8050556af2cfd62, 0xb3e1e1084f8f9345, 0xf5a4a4a1c86b4b06,
+		0x8b72722f70df379d, 0x94b2b233089a58fe, 0x3c6c6cc6b53703cc, 0x72cacab03b1d88a7,
+		0xf9b8b89f4bc2bf3a, 0x6af2f2cc20527ddf, 0x245454baae78f6b4, 0x02f1f1fe45bddd0a,
+	},
+	{
+		0x5d404b4bb6483ea2, 0x24ffb6b6808418c5, 0x4944b4b457c25905, 0x76bcfafab7254b5a,
+		0x345d7a7a5475c610, 0x19546f6ff4d0d8a3, 0xe7c0ddddc7d842fb, 0xc5cacfcfe6943175,
+		0xe4348f8f19bcf950, 0xdbccc1c1f95beb0f, 0xbf8160600e3cacb9, 0x1fa1cbcb5518b3e8,
+		0xd69c5151ec01540b, 0xe66718188d0f2b69, 0x92887575ae99b20a, 0x74ef6d6d23969963,
+		0x21fe4040ff28c825, 0x962e46469be20b78, 0xb08267678fd5c184, 0x220a1212214c738e,
+		0x7d19cece03b79f15, 0xdd39656558938044, 0xc93d9a9ab919e7e3, 0x917c272770fd09a1,
+		0x0000000000000000, 0x71ee9b9b5c3a4983, 0x2efd47477ec1a518, 0x79bffdfd36cc2667,
+		0xcd9ba9a98c625e91, 0x90dbe2e23a2a6033, 0x0cf755555f8dd696, 0x9c2cb7b765a7b6a5,
+		0x32a8dedef5bdad5b, 0x10a2ccccd4f1ded5, 0x88284848842dd102, 0x0ea4c2c2cb3e04af,
+		0xdecd373786f73bef, 0xe2c12b2bb874921b, 0x01a7c5c54ad76992, 0x43464545a987e4d8,
+		0x81deebeba40cd774, 0xb125a2a2c502a816, 0x29af262695dea7c1, 0x4115d2d23d3436e1,
+		0x30fb4949610e7f62, 0xd99f56566de83936, 0xa871cdcd31d270b5, 0x97898383d13562ea,
+		0x07526161eb1f02d9, 0x59e67878833387d0, 0x13569e9e0a95657e, 0xfd60e0e0ed6c21f3,
+		0x5eb41919682c8509, 0xcac9c8c8677d5c48, 0x70495e5e16ed2011, 0xc16cfcfcd3ef8807,
+		0x721ac9c9825ef228, 0xda6b0404b38c829d, 0xc79958587227e34c, 0x84df1d1ddba00794,
+		0x1404ffffe18a67a7, 0xc0cb39399938e195, 0x0ba53434b492d44f, 0xb376353551b17a2f,
+		0xea904d4dd282fdff, 0x51b71e1ee9c5e834, 0xb7d0060664cac35d, 0x26ac21211437cafc,
+		0x52434c4c37a1539f, 0x1b07f8f860630a9a, 0x4b172323c3718b3c, 0x838d7c7c30bf054d,
+		0x5010dbdba31281a6, 0x631fc0c01c78456f, 0xf9c6d3d3d8179881, 0xa6d50f0ffaec741a,
+		0xd4cfc6c678b28632, 0xd1ce3030071e56d2, 0xb2d1f0f01b6613bd, 0x2a5b74744bba1c6a,
+		0x315c8c8c2bd916f0, 0xf4964343cd4d2785, 0x5a122a2a5d573c7b, 0x3b5e7d7dd59cab2d,
+		0x947dd1d10f51d941, 0xbdd2f7f79a8f7e80, 0x5ce78e8efc9f5730, 0xba80969671907c59,
+		0xad703b3b4e7ea055, 0xec65e9e9734a96b4, 0xe1357979661029b0, 0x2bfcb1b1016d75f8,
+		0x8f7a29296f32d3db, 0x330f1b1bbf6ac4c9, 0x6e4f50500922fa6b, 0x18f3aaaabe07b131,
+		0xe092bcbc2cc74022, 0x441424244298e601, 0xb677c3c32e1daacf, 0x47e076769cfc5daa,
+		0xa2733c3ccf97cd68, 0x8bdc1a1a5a496aa9, 0xb5839191f0791164, 0x17f0adad3feedc0c,
+		0x3c0c1c1c3e83a9f4, 0xd56803033265efa0, 0x3ff84e4ee0e7125f, 0x898f8d8dcefab890,
+		0x03f45252de64bbab, 0xe8c3dada46312fc6, 0x6ae963633c594319, 0x4647b3b3d62b3438,
+		0xf197b5b5b2e1f765, 0x55112d2ddcbe5146, 0xc89a5f5ff3ce8e71, 0xbe26a5a544ebc52b,
+		0x5742baba480d837f, 0xf5318686879a4e17, 0x6b4ea6a6768e2a8b, 0xd39da7a793ad84eb,
+		0x60eb9292c21cfec4, 0x48e371711d153097, 0x04a63333357bb972, 0xc46d0a0aac4358e7,
+		0xbb2753533b4715cb, 0x7bec6a6aa27ff45e, 0xd069f5f54dc93f40, 0x40b2171777e35f73,
+		0x68baf4f4a8ea9120, 0x1aa03d3d2ab46308, 0x65ea6464bdb02e24, 0xf3c422222652255c,
+		0xc63e9d9d38f08ade, 0x872b4f4f05c4bc3f, 0x932fb0b0e44edb98, 0x38aa2f2f0bf81086,
+		0x25587373ca537157, 0x6fe8959543f593f9, 0xe9641f1f0ce64654, 0x5f13dcdc22fbec9b,
+		0x7cbe0b0b4960f687, 0xce6ffbfb5206e53a, 0xf263e7e76c854cce, 0xbc753232d0581712,
+		0x165768687539b59e, 0x54b6e8e8966938d4, 0xfb9544444ca44ab8, 0x868c8a8a4f13d5ad,
+		0x085166666af66fe4, 0x691d3131e23df8b2, 0xa48698986e5fa623, 0x53e489897d763a0d,
+		0xa5215d5d2488cfb1, 0xfa3281810673232a, 0x0f03070781e96d3d, 0xfcc72525a7bb4861,
+		0x0253979794b3d239, 0x0d509090155abf04, 0x8d29bebefb8101e2, 0xe5934a4a536b90c2,
+		0x9b7ed6d68eb8b47c, 0xdc9ea0a01244e9d6, 0xaf23acacdacd726c, 0xd8389393273f50a4,
+		0xab859f9fefb6cb1e, 0x5841bdbdc9e4ee42, 0x7eed9c9cddd324be, 0x7548a8a86941f0f1,
+		0xaa225a5aa561a28c, 0x4e16d5d5bcdd5bdc, 0x5bb5efef178055e9, 0x8a7bdfdf109e033b,
+		0x614c575788cb9756, 0xef91bbbbad2e2d1f, 0x20598585b5ffa1b7, 0x2d091515a0a51eb3,
+		0x1df25c5cc1ab61d1, 0x95da14144586b0d3, 0x8eddecec25e5ba49, 0x1e060e0e1fcfda7a,
+		0x9ad91313c46fddee, 0x23add7d76b9b1a1c, 0xff33777779dff3ca, 0xa020abab5b241f51,
+		0x360eededc0c61429, 0x2caed0d0ea727721, 0x8578d8d891776e06, 0x270be4e45ee0a36e,
+		0xee367e7ee7f9448d, 0x3dabd9d97454c066, 0x42e18080e3508d4a, 0xcb6e0d0d2daa35da,
+		0x992d41411a0b6645, 0x35fabfbf1ea2af82, 0x822ab9b97a686cdf, 0xf6c5d4d459fef5bc,
+		0x781838387c1b4ff5, 0xf0307070f8369ef7, 0x1c5599998b7c0843, 0x2808e3e3df09ce53,
+		0x7f4a595997044d2c, 0xa3d4f9f98540a4fa, 0xd73b9494a6d63d99, 0x0501f6f67facd0e0,
+		0xb974c4c4aff4c7f2, 0x4c454242286e89e5, 0x988a848450dc0fd7, 0x4fb11010f60a324e,
+		0xedc22c2c399dff26, 0x56e57f7f02daeaed, 0x6c1cc7c79d912852, 0x3e5f8b8baa307bcd,
+		0xb8d30101e523ae60, 0x661e363663d4958f, 0x771b3f3ffdf222c8, 0x8c8e7b7bb1566870,
+		0x67b9f3f32903fc1d, 0x6dbb0202d74641c0, 0xf762111113299c2e, 0xd23a6262d97aed79,
+		0x110509099e26b747, 0x9fd8e5e5bbc30d0e, 0xc33f6b6b475c5a3e, 0xc298aeae0d8b33ac,
+		0x9e7f2020f114649c, 0x73bd0c0cc8899bba, 0x4ab0e6e689a6e2ae, 0x390deaea412f7914,
+		0x4de2878762b9e077, 0xcfc83e3e18d18ca8, 0x80792e2eeedbbee6, 0x644da1a1f76747b6,
+		0x12f15b5b40420cec, 0x7a4bafafe8a89dcc, 0xa1876e6e11f376c3, 0xae846969901a1bfe,
+		0xf861161692c0f113, 0xe366eeeef2a3fb89, 0xa9d608087b051927, 0x09f6a3a320210676,
+		0xeb3788889855946d, 0xacd7fefe04a9c9c7, 0x37a928288a117dbb, 0x15a33a3aab5d0e35,
+		0x2f5a82823416cc8a, 0x62b8050556af2cfd, 0x45b3e1e1084f8f93, 0x06f5a4a4a1c86b4b,
+		0x9d8b72722f70df37, 0xfe94b2b233089a58, 0xcc3c6c6cc6b53703, 0xa772cacab03b1d88,
+		0x3af9b8b89f4bc2bf, 0xdf6af2f2cc20527d, 0xb4245454baae78f6, 0x0a02f1f1fe45bddd,
+	},
+	{
+		0xa25d404b4bb6483e, 0xc524ffb6b6808418, 0x054944b4b457c259, 0x5a76bcfafab7254b,
+		0x10345d7a7a5475c6, 0xa319546f6ff4d0d8, 0xfbe7c0ddddc7d842, 0x75c5cacfcfe69431,
+		0x50e4348f8f19bcf9, 0x0fdbccc1c1f95beb, 0xb9bf8160600e3cac, 0xe81fa1cbcb5518b3,
+		0x0bd69c5151ec0154, 0x69e66718188d0f2b, 0x0a92887575ae99b2, 0x6374ef6d6d239699,
+		0x2521fe4040ff28c8, 0x78962e46469be20b, 0x84b08267678fd5c1, 0x8e220a1212214c73,
+		0x157d19cece03b79f, 0x44dd396565589380, 0xe3c93d9a9ab919e7, 0xa1917c272770fd09,
+		0x0000000000000000, 0x8371ee9b9b5c3a49, 0x182efd47477ec1a5, 0x6779bffdfd36cc26,
+		0x91cd9ba9a98c625e, 0x3390dbe2e23a2a60, 0x960cf755555f8dd6, 0xa59c2cb7b765a7b6,
+		0x5b32a8dedef5bdad, 0xd510a2ccccd4f1de, 0x0288284848842dd1, 0xaf0ea4c2c2cb3e04,
+		0xefdecd373786f73b, 0x1be2c12b2bb87492, 0x9201a7c5c54ad769, 0xd843464545a987e4,
+		0x7481deebeba40cd7, 0x16b125a2a2c502a8, 0xc129af262695dea7, 0xe14115d2d23d3436,
+		0x6230fb4949610e7f, 0x36d99f56566de839, 0xb5a871cdcd31d270, 0xea97898383d13562,
+		0xd907526161eb1f02, 0xd059e67878833387, 0x7e13569e9e0a9565, 0xf3fd60e0e0ed6c21,
+		0x095eb41919682c85, 0x48cac9c8c8677d5c, 0x1170495e5e16ed20, 0x07c16cfcfcd3ef88,
+		0x28721ac9c9825ef2, 0x9dda6b0404b38c82, 0x4cc79958587227e3, 0x9484df1d1ddba007,
+		0xa71404ffffe18a67, 0x95c0cb39399938e1, 0x4f0ba53434b492d4, 0x2fb376353551b17a,
+		0xffea904d4dd282fd, 0x3451b71e1ee9c5e8, 0x5db7d0060664cac3, 0xfc26ac21211437ca,
+		0x9f52434c4c37a153, 0x9a1b07f8f860630a, 0x3c4b172323c3718b, 0x4d838d7c7c30bf05,
+		0xa65010dbdba31281, 0x6f631fc0c01c7845, 0x81f9c6d3d3d81798, 0x1aa6d50f0ffaec74,
+		0x32d4cfc6c678b286, 0xd2d1ce3030071e56, 0xbdb2d1f0f01b6613, 0x6a2a5b74744bba1c,
+		0xf0315c8c8c2bd916, 0x85f4964343cd4d27, 0x7b5a122a2a5d573c, 0x2d3b5e7d7dd59cab,
+		0x41947dd1d10f51d9, 0x80bdd2f7f79a8f7e, 0x305ce78e8efc9f57, 0x59ba80969671907c,
+		0x55ad703b3b4e7ea0, 0xb4ec65e9e9734a96, 0xb0e1357979661029, 0xf82bfcb1b1016d75,
+		0xdb8f7a29296f32d3, 0xc9330f1b1bbf6ac4, 0x6b6e4f50500922fa, 0x3118f3aaaabe07b1,
+		0x22e092bcbc2cc740, 0x01441424244298e6, 0xcfb677c3c32e1daa, 0xaa47e076769cfc5d,
+		0x68a2733c3ccf97cd, 0xa98bdc1a1a5a496a, 0x64b5839191f07911, 0x0c17f0adad3feedc,
+		0xf43c0c1c1c3e83a9, 0xa0d56803033265ef, 0x5f3ff84e4ee0e712, 0x90898f8d8dcefab8,
+		0xab03f45252de64bb, 0xc6e8c3dada46312f, 0x196ae963633c5943, 0x384647b3b3d62b34,
+		0x65f197b5b5b2e1f7, 0x4655112d2ddcbe51, 0x71c89a5f5ff3ce8e, 0x2bbe26a5a544ebc5,
+		0x7f5742baba480d83, 0x17f5318686879a4e, 0x8b6b4ea6a6768e2a, 0xebd39da7a793ad84,
+		0xc460eb9292c21cfe, 0x9748e371711d1530, 0x7204a63333357bb9, 0xe7c46d0a0aac4358,
+		0xcbbb2753533b4715, 0x5e7bec6a6aa27ff4, 0x40d069f5f54dc93f, 0x7340b2171777e35f,
+		0x2068baf4f4a8ea91, 0x081aa03d3d2ab463, 0x2465ea6464bdb02e, 0x5cf3c42222265225,
+		0xdec63e9d9d38f08a, 0x3f872b4f4f05c4bc, 0x98932fb0b0e44edb, 0x8638aa2f2f0bf810,
+		0x5725587373ca5371, 0xf96fe8959543f593, 0x54e9641f1f0ce646, 0x9b5f13dcdc22fbec,
+		0x877cbe0b0b4960f6, 0x3ace6ffbfb5206e5, 0xcef263e7e76c854c, 0x12bc753232d05817,
+		0x9e165768687539b5, 0xd454b6e8e8966938, 0xb8fb9544444ca44a, 0xad868c8a8a4f13d5,
+		0xe4085166666af66f, 0xb2691d3131e23df8, 0x23a48698986e5fa6, 0x0d53e489897d763a,
+		0xb1a5215d5d2488cf, 0x2afa328181067323, 0x3d0f03070781e96d, 0x61fcc72525a7bb48,
+		0x390253979794b3d2, 0x040d509090155abf, 0xe28d29bebefb8101, 0xc2e5934a4a536b90,
+		0x7c9b7ed6d68eb8b4, 0xd6dc9ea0a01244e9, 0x6caf23acacdacd72, 0xa4d8389393273f50,
+		0x1eab859f9fefb6cb, 0x425841bdbdc9e4ee, 0xbe7eed9c9cddd324, 0xf17548a8a86941f0,
+		0x8caa225a5aa561a2, 0xdc4e16d5d5bcdd5b, 0xe95bb5efef178055, 0x3b8a7bdfdf109e03,
+		0x56614c575788cb97, 0x1fef91bbbbad2e2d, 0xb720598585b5ffa1, 0xb32d091515a0a51e,
+		0xd11df25c5cc1ab61, 0xd395da14144586b0, 0x498eddecec25e5ba, 0x7a1e060e0e1fcfda,
+		0xee9ad91313c46fdd, 0x1c23add7d76b9b1a, 0xcaff33777779dff3, 0x51a020abab5b241f,
+		0x29360eededc0c614, 0x212caed0d0ea7277, 0x068578d8d891776e, 0x6e270be4e45ee0a3,
+		0x8dee367e7ee7f944, 0x663dabd9d97454c0, 0x4a42e18080e3508d, 0xdacb6e0d0d2daa35,
+		0x45992d41411a0b66, 0x8235fabfbf1ea2af, 0xdf822ab9b97a686c, 0xbcf6c5d4d459fef5,
+		0xf5781838387c1b4f, 0xf7f0307070f8369e, 0x431c5599998b7c08, 0x532808e3e3df09ce,
+		0x2c7f4a595997044d, 0xfaa3d4f9f98540a4, 0x99d73b9494a6d63d, 0xe00501f6f67facd0,
+		0xf2b974c4c4aff4c7, 0xe54c454242286e89, 0xd7988a848450dc0f, 0x4e4fb11010f60a32,
+		0x26edc22c2c399dff, 0xed56e57f7f02daea, 0x526c1cc7c79d9128, 0xcd3e5f8b8baa307b,
+		0x60b8d30101e523ae, 0x8f661e363663d495, 0xc8771b3f3ffdf222, 0x708c8e7b7bb15668,
+		0x1d67b9f3f32903fc, 0xc06dbb0202d74641, 0x2ef762111113299c, 0x79d23a6262d97aed,
+		0x47110509099e26b7, 0x0e9fd8e5e5bbc30d, 0x3ec33f6b6b475c5a, 0xacc298aeae0d8b33,
+		0x9c9e7f2020f11464, 0xba73bd0c0cc8899b, 0xae4ab0e6e689a6e2, 0x14390deaea412f79,
+		0x774de2878762b9e0, 0xa8cfc83e3e18d18c, 0xe680792e2eeedbbe, 0xb6644da1a1f76747,
+		0xec12f15b5b40420c, 0xcc7a4bafafe8a89d, 0xc3a1876e6e11f376, 0xfeae846969901a1b,
+		0x13f861161692c0f1, 0x89e366eeeef2a3fb, 0x27a9d608087b0519, 0x7609f6a3a3202106,
+		0x6deb378888985594, 0xc7acd7fefe04a9c9, 0xbb37a928288a117d, 0x3515a33a3aab5d0e,
+		0x8a2f5a82823416cc, 0xfd62b8050556af2c, 0x9345b3e1e1084f8f, 0x4b06f5a4a4a1c86b,
+		0x379d8b72722f70df, 0x58fe94b2b233089a, 0x03cc3c6c6cc6b537, 0x88a772cacab03b1d,
+		0xbf3af9b8b89f4bc2, 0x7ddf6af2f2cc2052, 0xf6b4245454baae78, 0xdd0a02f1f1fe45bd,
+	},
+}
+
+var _Sinv = [256]byte{
+	0x81, 0x06, 0x51, 0xdb, 0xbf, 0x36, 0x9e, 0x4b, 0x79, 0xf3, 0x2b, 0xe5, 0x15, 0xc3, 0xa2, 0x61,
+	0x32, 0xcb, 0x77, 0xd5, 0xee, 0x20, 0xf0, 0x45, 0x00, 0x55, 0x6e, 0x87, 0x99, 0x18, 0xbb, 0xa3,
+	0x6c, 0xb9, 0x73, 0x01, 0xc7, 0xaa, 0x5d, 0x39, 0xfc, 0x2a, 0xe0, 0x83, 0xd6, 0x49, 0x1c, 0x96,
+	0x8e, 0xe8, 0x5e, 0x4f, 0x66, 0x17, 0x08, 0x22, 0xb2, 0xae, 0xf1, 0xc8, 0xd0, 0x7f, 0x35, 0x90,
+	0x78, 0x3a, 0xf9, 0xbc, 0xdd, 0x8c, 0xeb, 0x46, 0x67, 0x56, 0x26, 0x1d, 0xaf, 0x9b, 0xcd, 0x07,
+	0x8b, 0xc0, 0x0f, 0xe3, 0x71, 0x91, 0xdc, 0x1f, 0x28, 0xab, 0x4d, 0x5a, 0xfd, 0x31, 0xb0, 0x6b,
+	0x10, 0xb7, 0xa4, 0x50, 0x74, 0x94, 0x43, 0x37, 0x6d, 0xf2, 0x8a, 0x2e, 0xe7, 0xc2, 0xd9, 0x0d,
+	0xf4, 0x53, 0xad, 0x76, 0xe9, 0x9d, 0x84, 0x21, 0xb1, 0x0c, 0x69, 0x16, 0x42, 0x3d, 0xc6, 0xde,
+	0x63, 0xd1, 0x85, 0x4e, 0xac, 0x2f, 0xff, 0x04, 0x5b, 0xed, 0x9f, 0x3b, 0xb3, 0x7e, 0x13, 0xcc,
+	0x6a, 0x0e, 0x9c, 0x72, 0xd2, 0x3e, 0xa7, 0x80, 0xfa, 0xc1, 0x5c, 0x12, 0xba, 0xe6, 0x47, 0x24,
+	0x2d, 0x7d, 0x92, 0x14, 0xfb, 0xb5, 0x09, 0x3c, 0xa6, 0xdf, 0x52, 0xc9, 0xec, 0x4c, 0x6f, 0x89,
+	0x5f, 0x2c, 0xa0, 0xb8, 0x70, 0x88, 0xf5, 0xce, 0x05, 0xd4, 0x95, 0xe1, 0x11, 0x30, 0x64, 0x4a,
+	0x97, 0xe2, 0x1b, 0x7a, 0xda, 0xa9, 0x02, 0xbd, 0x54, 0x29, 0x48, 0x34, 0xf8, 0x65, 0xca, 0x82,
+	0xf6, 0xb4, 0x0a, 0xd7, 0xa5, 0x62, 0x86, 0x1a, 0x3f, 0x57, 0x27, 0x7c, 0xe4, 0x44, 0x98, 0xc5,
+	0x19, 0xef, 0xb6, 0x59, 0x38, 0x23, 0xa1, 0xd8, 0x03, 0x60, 0x93, 0xcf, 0x7b, 0xf7, 0x41, 0x8f,
+	0x25, 0x75, 0x58, 0x8d, 0x33, 0x0b, 0xea, 0xd3, 0xfe, 0xa8, 0xc4, 0x40, 0xbe, 0x9a, 0x1e, 0x68,
+}
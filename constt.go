@@ -0,0 +1,540 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Lookup tables of Whirlpool-T, which uses the final S-box with the circulant
+// matrix cir(1, 1, 3, 1, 5, 8, 9, 5) of Whirlpool-0. Its round constants are
+// the same as those of the final version.
+
+package whirlpool
+
+var _WTC = [8][256]uint64{
+	{
+		0x1818281878c0d878, 0x23236523af0526af, 0xc6c657c6f97eb8f9, 0xe8e825e86f13fb6f,
+		0x87879487a14ccba1, 0xb8b8d5b862a91162, 0x0101030105080905, 0x4f4fd14f6e420d6e,
+		0x36365a36eead9bee, 0xa6a6f7a60459ff04, 0xd2d26bd2bdde0cbd, 0xf5f502f506fb0e06,
+		0x79798b7980ef9680, 0x6f6fb16fce5f30ce, 0x9191ae91effc6def, 0x5252f65207aaf807,
+		0x6060a060fd2747fd, 0xbcbcd9bc76893576, 0x9b9bb09bcdac37cd, 0x8e8e8f8e8c048a8c,
+		0xa3a3f8a31571d215, 0x0c0c140c3c606c3c, 0x7b7b8d7b8aff848a, 0x35355f35e1b580e1,
+		0x1d1d271d69e8f569, 0xe0e03de04753b347, 0xd7d764d7acf621ac, 0xc2c25bc2ed5e9ced,
+		0x2e2e722e966d4396, 0x4b4bdd4b7a62297a, 0xfefe1ffe21a35d21, 0x5757f9571682d516,
+		0x15153f1541a8bd41, 0x77779977b69fe8b6, 0x37375937eba592eb, 0xe5e532e5567b9e56,
+		0x9f9fbc9fd98c13d9, 0xf0f00df017d32317, 0x4a4ade4a7f6a207f, 0xdada73da959e4495,
+		0x5858e85825faa225, 0xc9c946c9ca06cfca, 0x29297b298d557c8d, 0x0a0a1e0a22505a22,
+		0xb1b1ceb14fe1504f, 0xa0a0fda01a69c91a, 0x6b6bbd6bda7f14da, 0x85859285ab5cd9ab,
+		0xbdbddabd73813c73, 0x5d5de75d34d28f34, 0x1010301050809050, 0xf4f401f403f30703,
+		0xcbcb40cbc016ddc0, 0x3e3e423ec6edd3c6, 0x05050f0511282d11, 0x6767a967e61f78e6,
+		0xe4e431e453739753, 0x27276927bb2502bb, 0x4141c34158327358, 0x8b8b808b9d2ca79d,
+		0xa7a7f4a70151f601, 0x7d7d877d94cfb294, 0x9595a295fbdc49fb, 0xd8d875d89f8e569f,
+		0xfbfb10fb308b7030, 0xeeee2fee7123cd71, 0x7c7c847c91c7bb91, 0x6666aa66e31771e3,
+		0xdddd7add8ea67b8e, 0x171739174bb8af4b, 0x4747c94746024546, 0x9e9ebf9edc841adc,
+		0xcaca43cac51ed4c5, 0x2d2d772d99755899, 0xbfbfdcbf79912e79, 0x070709071b383f1b,
+		0xadadeaad2301ac23, 0x5a5aee5a2feab02f, 0x83839883b56cefb5, 0x33335533ff85b6ff,
+		0x6363a563f23f5cf2, 0x020206020a10120a, 0xaaaae3aa38399338, 0x71719371a8afdea8,
+		0xc8c845c8cf0ec6cf, 0x19192b197dc8d17d, 0x4949db4970723b70, 0xd9d976d99a865f9a,
+		0xf2f20bf21dc3311d, 0xe3e338e3484ba848, 0x5b5bed5b2ae2b92a, 0x888885889234bc92,
+		0x9a9ab39ac8a43ec8, 0x26266a26be2d0bbe, 0x32325632fa8dbffa, 0xb0b0cdb04ae9594a,
+		0xe9e926e96a1bf26a, 0x0f0f110f33787733, 0xd5d562d5a6e633a6, 0x80809d80ba74f4ba,
+		0xbebedfbe7c99277c, 0xcdcd4acdde26ebde, 0x34345c34e4bd89e4, 0x4848d848757a3275,
+		0xffff1cff24ab5424, 0x7a7a8e7a8ff78d8f, 0x9090ad90eaf464ea, 0x5f5fe15f3ec29d3e,
+		0x20206020a01d3da0, 0x6868b868d5670fd5, 0x1a1a2e1a72d0ca72, 0xaeaeefae2c19b72c,
+		0xb4b4c1b45ec97d5e, 0x5454fc54199ace19, 0x9393a893e5ec7fe5, 0x22226622aa0d2faa,
+		0x6464ac64e90763e9, 0xf1f10ef112db2a12, 0x73739573a2bfcca2, 0x121236125a90825a,
+		0x4040c0405d3a7a5d, 0x0808180828404828, 0xc3c358c3e85695e8, 0xecec29ec7b33df7b,
+		0xdbdb70db90964d90, 0xa1a1fea11f61c01f, 0x8d8d8a8d831c9183, 0x3d3d473dc9f5c8c9,
+		0x9797a497f1cc5bf1, 0x0000000000000000, 0xcfcf4ccfd436f9d4, 0x2b2b7d2b87456e87,
+		0x76769a76b397e1b3, 0x82829b82b064e6b0, 0xd6d667d6a9fe28a9, 0x1b1b2d1b77d8c377,
+		0xb5b5c2b55bc1745b, 0xafafecaf2911be29, 0x6a6abe6adf771ddf, 0x5050f0500dbaea0d,
+		0x4545cf454c12574c, 0xf3f308f318cb3818, 0x30305030f09dadf0, 0xefef2cef742bc474,
+		0x3f3f413fc3e5dac3, 0x5555ff551c92c71c, 0xa2a2fba21079db10, 0xeaea23ea6503e965,
+		0x6565af65ec0f6aec, 0xbabad3ba68b90368, 0x2f2f712f93654a93, 0xc0c05dc0e74e8ee7,
+		0xdede7fde81be6081, 0x1c1c241c6ce0fc6c, 0xfdfd1afd2ebb462e, 0x4d4dd74d64521f64,
+		0x9292ab92e0e476e0, 0x75759f75bc8ffabc, 0x06060a061e30361e, 0x8a8a838a9824ae98,
+		0xb2b2cbb240f94b40, 0xe6e637e659638559, 0x0e0e120e36707e36, 0x1f1f211f63f8e763,
+		0x6262a662f73755f7, 0xd4d461d4a3ee3aa3, 0xa8a8e5a832298132, 0x9696a796f4c452f4,
+		0xf9f916f93a9b623a, 0xc5c552c5f666a3f6, 0x25256f25b13510b1, 0x5959eb5920f2ab20,
+		0x84849184ae54d0ae, 0x72729672a7b7c5a7, 0x39394b39ddd5ecdd, 0x4c4cd44c615a1661,
+		0x5e5ee25e3bca943b, 0x7878887885e79f85, 0x38384838d8dde5d8, 0x8c8c898c86149886,
+		0xd1d16ed1b2c617b2, 0xa5a5f2a50b41e40b, 0xe2e23be24d43a14d, 0x6161a361f82f4ef8,
+		0xb3b3c8b345f14245, 0x21216321a51534a5, 0x9c9cb99cd69408d6, 0x1e1e221e66f0ee66,
+		0x4343c54352226152, 0xc7c754c7fc76b1fc, 0xfcfc19fc2bb34f2b, 0x04040c0414202414,
+		0x5151f35108b2e308, 0x9999b699c7bc25c7, 0x6d6db76dc44f22c4, 0x0d0d170d39686539,
+		0xfafa13fa35837935, 0xdfdf7cdf84b66984, 0x7e7e827e9bd7a99b, 0x24246c24b43d19b4,
+		0x3b3b4d3bd7c5fed7, 0xababe0ab3d319a3d, 0xcece4fced13ef0d1, 0x1111331155889955,
+		0x8f8f8c8f890c8389, 0x4e4ed24e6b4a046b, 0xb7b7c4b751d16651, 0xebeb20eb600be060,
+		0x3c3c443cccfdc1cc, 0x81819e81bf7cfdbf, 0x9494a194fed440fe, 0xf7f704f70ceb1c0c,
+		0xb9b9d6b967a11867, 0x131335135f988b5f, 0x2c2c742c9c7d519c, 0xd3d368d3b8d605b8,
+		0xe7e734e75c6b8c5c, 0x6e6eb26ecb5739cb, 0xc4c451c4f36eaaf3, 0x030305030f181b0f,
+		0x5656fa56138adc13, 0x4444cc44491a5e49, 0x7f7f817f9edfa09e, 0xa9a9e6a937218837,
+		0x2a2a7e2a824d6782, 0xbbbbd0bb6db10a6d, 0xc1c15ec1e24687e2, 0x5353f55302a2f102,
+		0xdcdc79dc8bae728b, 0x0b0b1d0b27585327, 0x9d9dba9dd39c01d3, 0x6c6cb46cc1472bc1,
+		0x31315331f595a4f5, 0x74749c74b987f3b9, 0xf6f607f609e31509, 0x4646ca46430a4c43,
+		0xacace9ac2609a526, 0x89898689973cb597, 0x14143c1444a0b444, 0xe1e13ee1425bba42,
+		0x16163a164eb0a64e, 0x3a3a4e3ad2cdf7d2, 0x6969bb69d06f06d0, 0x09091b092d48412d,
+		0x70709070ada7d7ad, 0xb6b6c7b654d96f54, 0xd0d06dd0b7ce1eb7, 0xeded2aed7e3bd67e,
+		0xcccc49ccdb2ee2db, 0x4242c642572a6857, 0x9898b598c2b42cc2, 0xa4a4f1a40e49ed0e,
+		0x28287828885d7588, 0x5c5ce45c31da8631, 0xf8f815f83f936b3f, 0x86869786a444c2a4,
+	},
+	{
+		0x781818281878c0d8, 0xaf23236523af0526, 0xf9c6c657c6f97eb8, 0x6fe8e825e86f13fb,
+		0xa187879487a14ccb, 0x62b8b8d5b862a911, 0x0501010301050809, 0x6e4f4fd14f6e420d,
+		0xee36365a36eead9b, 0x04a6a6f7a60459ff, 0xbdd2d26bd2bdde0c, 0x06f5f502f506fb0e,
+		0x8079798b7980ef96, 0xce6f6fb16fce5f30, 0xef9191ae91effc6d, 0x075252f65207aaf8,
+		0xfd6060a060fd2747, 0x76bcbcd9bc768935, 0xcd9b9bb09bcdac37, 0x8c8e8e8f8e8c048a,
+		0x15a3a3f8a31571d2, 0x3c0c0c140c3c606c, 0x8a7b7b8d7b8aff84, 0xe135355f35e1b580,
+		0x691d1d271d69e8f5, 0x47e0e03de04753b3, 0xacd7d764d7acf621, 0xedc2c25bc2ed5e9c,
+		0x962e2e722e966d43, 0x7a4b4bdd4b7a6229, 0x21fefe1ffe21a35d, 0x165757f9571682d5,
+		0x4115153f1541a8bd, 0xb677779977b69fe8, 0xeb37375937eba592, 0x56e5e532e5567b9e,
+		0xd99f9fbc9fd98c13, 0x17f0f00df017d323, 0x7f4a4ade4a7f6a20, 0x95dada73da959e44,
+		0x255858e85825faa2, 0xcac9c946c9ca06cf, 0x8d29297b298d557c, 0x220a0a1e0a22505a,
+		0x4fb1b1ceb14fe150, 0x1aa0a0fda01a69c9, 0xda6b6bbd6bda7f14, 0xab85859285ab5cd9,
+		0x73bdbddabd73813c, 0x345d5de75d34d28f, 0x5010103010508090, 0x03f4f401f403f307,
+		0xc0cbcb40cbc016dd, 0xc63e3e423ec6edd3, 0x1105050f0511282d, 0xe66767a967e61f78,
+		0x53e4e431e4537397, 0xbb27276927bb2502, 0x584141c341583273, 0x9d8b8b808b9d2ca7,
+		0x01a7a7f4a70151f6, 0x947d7d877d94cfb2, 0xfb9595a295fbdc49, 0x9fd8d875d89f8e56,
+		0x30fbfb10fb308b70, 0x71eeee2fee7123cd, 0x917c7c847c91c7bb, 0xe36666aa66e31771,
+		0x8edddd7add8ea67b, 0x4b171739174bb8af, 0x464747c947460245, 0xdc9e9ebf9edc841a,
+		0xc5caca43cac51ed4, 0x992d2d772d997558, 0x79bfbfdcbf79912e, 0x1b070709071b383f,
+		0x23adadeaad2301ac, 0x2f5a5aee5a2feab0, 0xb583839883b56cef, 0xff33335533ff85b6,
+		0xf26363a563f23f5c, 0x0a020206020a1012, 0x38aaaae3aa383993, 0xa871719371a8afde,
+		0xcfc8c845c8cf0ec6, 0x7d19192b197dc8d1, 0x704949db4970723b, 0x9ad9d976d99a865f,
+		0x1df2f20bf21dc331, 0x48e3e338e3484ba8, 0x2a5b5bed5b2ae2b9, 0x92888885889234bc,
+		0xc89a9ab39ac8a43e, 0xbe26266a26be2d0b, 0xfa32325632fa8dbf, 0x4ab0b0cdb04ae959,
+		0x6ae9e926e96a1bf2, 0x330f0f110f337877, 0xa6d5d562d5a6e633, 0xba80809d80ba74f4,
+		0x7cbebedfbe7c9927, 0xdecdcd4acdde26eb, 0xe434345c34e4bd89, 0x754848d848757a32,
+		0x24ffff1cff24ab54, 0x8f7a7a8e7a8ff78d, 0xea9090ad90eaf464, 0x3e5f5fe15f3ec29d,
+		0xa020206020a01d3d, 0xd56868b868d5670f, 0x721a1a2e1a72d0ca, 0x2caeaeefae2c19b7,
+		0x5eb4b4c1b45ec97d, 0x195454fc54199ace, 0xe59393a893e5ec7f, 0xaa22226622aa0d2f,
+		0xe96464ac64e90763, 0x12f1f10ef112db2a, 0xa273739573a2bfcc, 0x5a121236125a9082,
+		0x5d4040c0405d3a7a, 0x2808081808284048, 0xe8c3c358c3e85695, 0x7becec29ec7b33df,
+		0x90dbdb70db90964d, 0x1fa1a1fea11f61c0, 0x838d8d8a8d831c91, 0xc93d3d473dc9f5c8,
+		0xf19797a497f1cc5b, 0x0000000000000000, 0xd4cfcf4ccfd436f9, 0x872b2b7d2b87456e,
+		0xb376769a76b397e1, 0xb082829b82b064e6, 0xa9d6d667d6a9fe28, 0x771b1b2d1b77d8c3,
+		0x5bb5b5c2b55bc174, 0x29afafecaf2911be, 0xdf6a6abe6adf771d, 0x0d5050f0500dbaea,
+		0x4c4545cf454c1257, 0x18f3f308f318cb38, 0xf030305030f09dad, 0x74efef2cef742bc4,
+		0xc33f3f413fc3e5da, 0x1c5555ff551c92c7, 0x10a2a2fba21079db, 0x65eaea23ea6503e9,
+		0xec6565af65ec0f6a, 0x68babad3ba68b903, 0x932f2f712f93654a, 0xe7c0c05dc0e74e8e,
+		0x81dede7fde81be60, 0x6c1c1c241c6ce0fc, 0x2efdfd1afd2ebb46, 0x644d4dd74d64521f,
+		0xe09292ab92e0e476, 0xbc75759f75bc8ffa, 0x1e06060a061e3036, 0x988a8a838a9824ae,
+		0x40b2b2cbb240f94b, 0x59e6e637e6596385, 0x360e0e120e36707e, 0x631f1f211f63f8e7,
+		0xf76262a662f73755, 0xa3d4d461d4a3ee3a, 0x32a8a8e5a8322981, 0xf49696a796f4c452,
+		0x3af9f916f93a9b62, 0xf6c5c552c5f666a3, 0xb125256f25b13510, 0x205959eb5920f2ab,
+		0xae84849184ae54d0, 0xa772729672a7b7c5, 0xdd39394b39ddd5ec, 0x614c4cd44c615a16,
+		0x3b5e5ee25e3bca94, 0x857878887885e79f, 0xd838384838d8dde5, 0x868c8c898c861498,
+		0xb2d1d16ed1b2c617, 0x0ba5a5f2a50b41e4, 0x4de2e23be24d43a1, 0xf86161a361f82f4e,
+		0x45b3b3c8b345f142, 0xa521216321a51534, 0xd69c9cb99cd69408, 0x661e1e221e66f0ee,
+		0x524343c543522261, 0xfcc7c754c7fc76b1, 0x2bfcfc19fc2bb34f, 0x1404040c04142024,
+		0x085151f35108b2e3, 0xc79999b699c7bc25, 0xc46d6db76dc44f22, 0x390d0d170d396865,
+		0x35fafa13fa358379, 0x84dfdf7cdf84b669, 0x9b7e7e827e9bd7a9, 0xb424246c24b43d19,
+		0xd73b3b4d3bd7c5fe, 0x3dababe0ab3d319a, 0xd1cece4fced13ef0, 0x5511113311558899,
+		0x898f8f8c8f890c83, 0x6b4e4ed24e6b4a04, 0x51b7b7c4b751d166, 0x60ebeb20eb600be0,
+		0xcc3c3c443cccfdc1, 0xbf81819e81bf7cfd, 0xfe9494a194fed440, 0x0cf7f704f70ceb1c,
+		0x67b9b9d6b967a118, 0x5f131335135f988b, 0x9c2c2c742c9c7d51, 0xb8d3d368d3b8d605,
+		0x5ce7e734e75c6b8c, 0xcb6e6eb26ecb5739, 0xf3c4c451c4f36eaa, 0x0f030305030f181b,
+		0x135656fa56138adc, 0x494444cc44491a5e, 0x9e7f7f817f9edfa0, 0x37a9a9e6a9372188,
+		0x822a2a7e2a824d67, 0x6dbbbbd0bb6db10a, 0xe2c1c15ec1e24687, 0x025353f55302a2f1,
+		0x8bdcdc79dc8bae72, 0x270b0b1d0b275853, 0xd39d9dba9dd39c01, 0xc16c6cb46cc1472b,
+		0xf531315331f595a4, 0xb974749c74b987f3, 0x09f6f607f609e315, 0x434646ca46430a4c,
+		0x26acace9ac2609a5, 0x9789898689973cb5, 0x4414143c1444a0b4, 0x42e1e13ee1425bba,
+		0x4e16163a164eb0a6, 0xd23a3a4e3ad2cdf7, 0xd06969bb69d06f06, 0x2d09091b092d4841,
+		0xad70709070ada7d7, 0x54b6b6c7b654d96f, 0xb7d0d06dd0b7ce1e, 0x7eeded2aed7e3bd6,
+		0xdbcccc49ccdb2ee2, 0x574242c642572a68, 0xc29898b598c2b42c, 0x0ea4a4f1a40e49ed,
+		0x8828287828885d75, 0x315c5ce45c31da86, 0x3ff8f815f83f936b, 0xa486869786a444c2,
+	},
+	{
+		0xd8781818281878c0, 0x26af23236523af05, 0xb8f9c6c657c6f97e, 0xfb6fe8e825e86f13,
+		0xcba187879487a14c, 0x1162b8b8d5b862a9, 0x0905010103010508, 0x0d6e4f4fd14f6e42,
+		0x9bee36365a36eead, 0xff04a6a6f7a60459, 0x0cbdd2d26bd2bdde, 0x0e06f5f502f506fb,
+		0x968079798b7980ef, 0x30ce6f6fb16fce5f, 0x6def9191ae91effc, 0xf8075252f65207aa,
+		0x47fd6060a060fd27, 0x3576bcbcd9bc7689, 0x37cd9b9bb09bcdac, 0x8a8c8e8e8f8e8c04,
+		0xd215a3a3f8a31571, 0x6c3c0c0c140c3c60, 0x848a7b7b8d7b8aff, 0x80e135355f35e1b5,
+		0xf5691d1d271d69e8, 0xb347e0e03de04753, 0x21acd7d764d7acf6, 0x9cedc2c25bc2ed5e,
+		0x43962e2e722e966d, 0x297a4b4bdd4b7a62, 0x5d21fefe1ffe21a3, 0xd5165757f9571682,
+		0xbd4115153f1541a8, 0xe8b677779977b69f, 0x92eb37375937eba5, 0x9e56e5e532e5567b,
+		0x13d99f9fbc9fd98c, 0x2317f0f00df017d3, 0x207f4a4ade4a7f6a, 0x4495dada73da959e,
+		0xa2255858e85825fa, 0xcfcac9c946c9ca06, 0x7c8d29297b298d55, 0x5a220a0a1e0a2250,
+		0x504fb1b1ceb14fe1, 0xc91aa0a0fda01a69, 0x14da6b6bbd6bda7f, 0xd9ab85859285ab5c,
+		0x3c73bdbddabd7381, 0x8f345d5de75d34d2, 0x9050101030105080, 0x0703f4f401f403f3,
+		0xddc0cbcb40cbc016, 0xd3c63e3e423ec6ed, 0x2d1105050f051128, 0x78e66767a967e61f,
+		0x9753e4e431e45373, 0x02bb27276927bb25, 0x73584141c3415832, 0xa79d8b8b808b9d2c,
+		0xf601a7a7f4a70151, 0xb2947d7d877d94cf, 0x49fb9595a295fbdc, 0x569fd8d875d89f8e,
+		0x7030fbfb10fb308b, 0xcd71eeee2fee7123, 0xbb917c7c847c91c7, 0x71e36666aa66e317,
+		0x7b8edddd7add8ea6, 0xaf4b171739174bb8, 0x45464747c9474602, 0x1adc9e9ebf9edc84,
+		0xd4c5caca43cac51e, 0x58992d2d772d9975, 0x2e79bfbfdcbf7991, 0x3f1b070709071b38,
+		0xac23adadeaad2301, 0xb02f5a5aee5a2fea, 0xefb583839883b56c, 0xb6ff33335533ff85,
+		0x5cf26363a563f23f, 0x120a020206020a10, 0x9338aaaae3aa3839, 0xdea871719371a8af,
+		0xc6cfc8c845c8cf0e, 0xd17d19192b197dc8, 0x3b704949db497072, 0x5f9ad9d976d99a86,
+		0x311df2f20bf21dc3, 0xa848e3e338e3484b, 0xb92a5b5bed5b2ae2, 0xbc92888885889234,
+		0x3ec89a9ab39ac8a4, 0x0bbe26266a26be2d, 0xbffa32325632fa8d, 0x594ab0b0cdb04ae9,
+		0xf26ae9e926e96a1b, 0x77330f0f110f3378, 0x33a6d5d562d5a6e6, 0xf4ba80809d80ba74,
+		0x277cbebedfbe7c99, 0xebdecdcd4acdde26, 0x89e434345c34e4bd, 0x32754848d848757a,
+		0x5424ffff1cff24ab, 0x8d8f7a7a8e7a8ff7, 0x64ea9090ad90eaf4, 0x9d3e5f5fe15f3ec2,
+		0x3da020206020a01d, 0x0fd56868b868d567, 0xca721a1a2e1a72d0, 0xb72caeaeefae2c19,
+		0x7d5eb4b4c1b45ec9, 0xce195454fc54199a, 0x7fe59393a893e5ec, 0x2faa22226622aa0d,
+		0x63e96464ac64e907, 0x2a12f1f10ef112db, 0xcca273739573a2bf, 0x825a121236125a90,
+		0x7a5d4040c0405d3a, 0x4828080818082840, 0x95e8c3c358c3e856, 0xdf7becec29ec7b33,
+		0x4d90dbdb70db9096, 0xc01fa1a1fea11f61, 0x91838d8d8a8d831c, 0xc8c93d3d473dc9f5,
+		0x5bf19797a497f1cc, 0x0000000000000000, 0xf9d4cfcf4ccfd436, 0x6e872b2b7d2b8745,
+		0xe1b376769a76b397, 0xe6b082829b82b064, 0x28a9d6d667d6a9fe, 0xc3771b1b2d1b77d8,
+		0x745bb5b5c2b55bc1, 0xbe29afafecaf2911, 0x1ddf6a6abe6adf77, 0xea0d5050f0500dba,
+		0x574c4545cf454c12, 0x3818f3f308f318cb, 0xadf030305030f09d, 0xc474efef2cef742b,
+		0xdac33f3f413fc3e5, 0xc71c5555ff551c92, 0xdb10a2a2fba21079, 0xe965eaea23ea6503,
+		0x6aec6565af65ec0f, 0x0368babad3ba68b9, 0x4a932f2f712f9365, 0x8ee7c0c05dc0e74e,
+		0x6081dede7fde81be, 0xfc6c1c1c241c6ce0, 0x462efdfd1afd2ebb, 0x1f644d4dd74d6452,
+		0x76e09292ab92e0e4, 0xfabc75759f75bc8f, 0x361e06060a061e30, 0xae988a8a838a9824,
+		0x4b40b2b2cbb240f9, 0x8559e6e637e65963, 0x7e360e0e120e3670, 0xe7631f1f211f63f8,
+		0x55f76262a662f737, 0x3aa3d4d461d4a3ee, 0x8132a8a8e5a83229, 0x52f49696a796f4c4,
+		0x623af9f916f93a9b, 0xa3f6c5c552c5f666, 0x10b125256f25b135, 0xab205959eb5920f2,
+		0xd0ae84849184ae54, 0xc5a772729672a7b7, 0xecdd39394b39ddd5, 0x16614c4cd44c615a,
+		0x943b5e5ee25e3bca, 0x9f857878887885e7, 0xe5d838384838d8dd, 0x98868c8c898c8614,
+		0x17b2d1d16ed1b2c6, 0xe40ba5a5f2a50b41, 0xa14de2e23be24d43, 0x4ef86161a361f82f,
+		0x4245b3b3c8b345f1, 0x34a521216321a515, 0x08d69c9cb99cd694, 0xee661e1e221e66f0,
+		0x61524343c5435222, 0xb1fcc7c754c7fc76, 0x4f2bfcfc19fc2bb3, 0x241404040c041420,
+		0xe3085151f35108b2, 0x25c79999b699c7bc, 0x22c46d6db76dc44f, 0x65390d0d170d3968,
+		0x7935fafa13fa3583, 0x6984dfdf7cdf84b6, 0xa99b7e7e827e9bd7, 0x19b424246c24b43d,
+		0xfed73b3b4d3bd7c5, 0x9a3dababe0ab3d31, 0xf0d1cece4fced13e, 0x9955111133115588,
+		0x83898f8f8c8f890c, 0x046b4e4ed24e6b4a, 0x6651b7b7c4b751d1, 0xe060ebeb20eb600b,
+		0xc1cc3c3c443cccfd, 0xfdbf81819e81bf7c, 0x40fe9494a194fed4, 0x1c0cf7f704f70ceb,
+		0x1867b9b9d6b967a1, 0x8b5f131335135f98, 0x519c2c2c742c9c7d, 0x05b8d3d368d3b8d6,
+		0x8c5ce7e734e75c6b, 0x39cb6e6eb26ecb57, 0xaaf3c4c451c4f36e, 0x1b0f030305030f18,
+		0xdc135656fa56138a, 0x5e494444cc44491a, 0xa09e7f7f817f9edf, 0x8837a9a9e6a93721,
+		0x67822a2a7e2a824d, 0x0a6dbbbbd0bb6db1, 0x87e2c1c15ec1e246, 0xf1025353f55302a2,
+		0x728bdcdc79dc8bae, 0x53270b0b1d0b2758, 0x01d39d9dba9dd39c, 0x2bc16c6cb46cc147,
+		0xa4f531315331f595, 0xf3b974749c74b987, 0x1509f6f607f609e3, 0x4c434646ca46430a,
+		0xa526acace9ac2609, 0xb59789898689973c, 0xb44414143c1444a0, 0xba42e1e13ee1425b,
+		0xa64e16163a164eb0, 0xf7d23a3a4e3ad2cd, 0x06d06969bb69d06f, 0x412d09091b092d48,
+		0xd7ad70709070ada7, 0x6f54b6b6c7b654d9, 0x1eb7d0d06dd0b7ce, 0xd67eeded2aed7e3b,
+		0xe2dbcccc49ccdb2e, 0x68574242c642572a, 0x2cc29898b598c2b4, 0xed0ea4a4f1a40e49,
+		0x758828287828885d, 0x86315c5ce45c31da, 0x6b3ff8f815f83f93, 0xc2a486869786a444,
+	},
+	{
+		0xc0d8781818281878, 0x0526af23236523af, 0x7eb8f9c6c657c6f9, 0x13fb6fe8e825e86f,
+		0x4ccba187879487a1, 0xa91162b8b8d5b862, 0x0809050101030105, 0x420d6e4f4fd14f6e,
+		0xad9bee36365a36ee, 0x59ff04a6a6f7a604, 0xde0cbdd2d26bd2bd, 0xfb0e06f5f502f506,
+		0xef968079798b7980, 0x5f30ce6f6fb16fce, 0xfc6def9191ae91ef, 0xaaf8075252f65207,
+		0x2747fd6060a060fd, 0x893576bcbcd9bc76, 0xac37cd9b9bb09bcd, 0x048a8c8e8e8f8e8c,
+		0x71d215a3a3f8a315, 0x606c3c0c0c140c3c, 0xff848a7b7b8d7b8a, 0xb580e135355f35e1,
+		0xe8f5691d1d271d69, 0x53b347e0e03de047, 0xf621acd7d764d7ac, 0x5e9cedc2c25bc2ed,
+		0x6d43962e2e722e96, 0x62297a4b4bdd4b7a, 0xa35d21fefe1ffe21, 0x82d5165757f95716,
+		0xa8bd4115153f1541, 0x9fe8b677779977b6, 0xa592eb37375937eb, 0x7b9e56e5e532e556,
+		0x8c13d99f9fbc9fd9, 0xd32317f0f00df017, 0x6a207f4a4ade4a7f, 0x9e4495dada73da95,
+		0xfaa2255858e85825, 0x06cfcac9c946c9ca, 0x557c8d29297b298d, 0x505a220a0a1e0a22,
+		0xe1504fb1b1ceb14f, 0x69c91aa0a0fda01a, 0x7f14da6b6bbd6bda, 0x5cd9ab85859285ab,
+		0x813c73bdbddabd73, 0xd28f345d5de75d34, 0x8090501010301050, 0xf30703f4f401f403,
+		0x16ddc0cbcb40cbc0, 0xedd3c63e3e423ec6, 0x282d1105050f0511, 0x1f78e66767a967e6,
+		0x739753e4e431e453, 0x2502bb27276927bb, 0x3273584141c34158, 0x2ca79d8b8b808b9d,
+		0x51f601a7a7f4a701, 0xcfb2947d7d877d94, 0xdc49fb9595a295fb, 0x8e569fd8d875d89f,
+		0x8b7030fbfb10fb30, 0x23cd71eeee2fee71, 0xc7bb917c7c847c91, 0x1771e36666aa66e3,
+		0xa67b8edddd7add8e, 0xb8af4b171739174b, 0x0245464747c94746, 0x841adc9e9ebf9edc,
+		0x1ed4c5caca43cac5, 0x7558992d2d772d99, 0x912e79bfbfdcbf79, 0x383f1b070709071b,
+		0x01ac23adadeaad23, 0xeab02f5a5aee5a2f, 0x6cefb583839883b5, 0x85b6ff33335533ff,
+		0x3f5cf26363a563f2, 0x10120a020206020a, 0x399338aaaae3aa38, 0xafdea871719371a8,
+		0x0ec6cfc8c845c8cf, 0xc8d17d19192b197d, 0x723b704949db4970, 0x865f9ad9d976d99a,
+		0xc3311df2f20bf21d, 0x4ba848e3e338e348, 0xe2b92a5b5bed5b2a, 0x34bc928888858892,
+		0xa43ec89a9ab39ac8, 0x2d0bbe26266a26be, 0x8dbffa32325632fa, 0xe9594ab0b0cdb04a,
+		0x1bf26ae9e926e96a, 0x7877330f0f110f33, 0xe633a6d5d562d5a6, 0x74f4ba80809d80ba,
+		0x99277cbebedfbe7c, 0x26ebdecdcd4acdde, 0xbd89e434345c34e4, 0x7a32754848d84875,
+		0xab5424ffff1cff24, 0xf78d8f7a7a8e7a8f, 0xf464ea9090ad90ea, 0xc29d3e5f5fe15f3e,
+		0x1d3da020206020a0, 0x670fd56868b868d5, 0xd0ca721a1a2e1a72, 0x19b72caeaeefae2c,
+		0xc97d5eb4b4c1b45e, 0x9ace195454fc5419, 0xec7fe59393a893e5, 0x0d2faa22226622aa,
+		0x0763e96464ac64e9, 0xdb2a12f1f10ef112, 0xbfcca273739573a2, 0x90825a121236125a,
+		0x3a7a5d4040c0405d, 0x4048280808180828, 0x5695e8c3c358c3e8, 0x33df7becec29ec7b,
+		0x964d90dbdb70db90, 0x61c01fa1a1fea11f, 0x1c91838d8d8a8d83, 0xf5c8c93d3d473dc9,
+		0xcc5bf19797a497f1, 0x0000000000000000, 0x36f9d4cfcf4ccfd4, 0x456e872b2b7d2b87,
+		0x97e1b376769a76b3, 0x64e6b082829b82b0, 0xfe28a9d6d667d6a9, 0xd8c3771b1b2d1b77,
+		0xc1745bb5b5c2b55b, 0x11be29afafecaf29, 0x771ddf6a6abe6adf, 0xbaea0d5050f0500d,
+		0x12574c4545cf454c, 0xcb3818f3f308f318, 0x9dadf030305030f0, 0x2bc474efef2cef74,
+		0xe5dac33f3f413fc3, 0x92c71c5555ff551c, 0x79db10a2a2fba210, 0x03e965eaea23ea65,
+		0x0f6aec6565af65ec, 0xb90368babad3ba68, 0x654a932f2f712f93, 0x4e8ee7c0c05dc0e7,
+		0xbe6081dede7fde81, 0xe0fc6c1c1c241c6c, 0xbb462efdfd1afd2e, 0x521f644d4dd74d64,
+		0xe476e09292ab92e0, 0x8ffabc75759f75bc, 0x30361e06060a061e, 0x24ae988a8a838a98,
+		0xf94b40b2b2cbb240, 0x638559e6e637e659, 0x707e360e0e120e36, 0xf8e7631f1f211f63,
+		0x3755f76262a662f7, 0xee3aa3d4d461d4a3, 0x298132a8a8e5a832, 0xc452f49696a796f4,
+		0x9b623af9f916f93a, 0x66a3f6c5c552c5f6, 0x3510b125256f25b1, 0xf2ab205959eb5920,
+		0x54d0ae84849184ae, 0xb7c5a772729672a7, 0xd5ecdd39394b39dd, 0x5a16614c4cd44c61,
+		0xca943b5e5ee25e3b, 0xe79f857878887885, 0xdde5d838384838d8, 0x1498868c8c898c86,
+		0xc617b2d1d16ed1b2, 0x41e40ba5a5f2a50b, 0x43a14de2e23be24d, 0x2f4ef86161a361f8,
+		0xf14245b3b3c8b345, 0x1534a521216321a5, 0x9408d69c9cb99cd6, 0xf0ee661e1e221e66,
+		0x2261524343c54352, 0x76b1fcc7c754c7fc, 0xb34f2bfcfc19fc2b, 0x20241404040c0414,
+		0xb2e3085151f35108, 0xbc25c79999b699c7, 0x4f22c46d6db76dc4, 0x6865390d0d170d39,
+		0x837935fafa13fa35, 0xb66984dfdf7cdf84, 0xd7a99b7e7e827e9b, 0x3d19b424246c24b4,
+		0xc5fed73b3b4d3bd7, 0x319a3dababe0ab3d, 0x3ef0d1cece4fced1, 0x8899551111331155,
+		0x0c83898f8f8c8f89, 0x4a046b4e4ed24e6b, 0xd16651b7b7c4b751, 0x0be060ebeb20eb60,
+		0xfdc1cc3c3c443ccc, 0x7cfdbf81819e81bf, 0xd440fe9494a194fe, 0xeb1c0cf7f704f70c,
+		0xa11867b9b9d6b967, 0x988b5f131335135f, 0x7d519c2c2c742c9c, 0xd605b8d3d368d3b8,
+		0x6b8c5ce7e734e75c, 0x5739cb6e6eb26ecb, 0x6eaaf3c4c451c4f3, 0x181b0f030305030f,
+		0x8adc135656fa5613, 0x1a5e494444cc4449, 0xdfa09e7f7f817f9e, 0x218837a9a9e6a937,
+		0x4d67822a2a7e2a82, 0xb10a6dbbbbd0bb6d, 0x4687e2c1c15ec1e2, 0xa2f1025353f55302,
+		0xae728bdcdc79dc8b, 0x5853270b0b1d0b27, 0x9c01d39d9dba9dd3, 0x472bc16c6cb46cc1,
+		0x95a4f531315331f5, 0x87f3b974749c74b9, 0xe31509f6f607f609, 0x0a4c434646ca4643,
+		0x09a526acace9ac26, 0x3cb5978989868997, 0xa0b44414143c1444, 0x5bba42e1e13ee142,
+		0xb0a64e16163a164e, 0xcdf7d23a3a4e3ad2, 0x6f06d06969bb69d0, 0x48412d09091b092d,
+		0xa7d7ad70709070ad, 0xd96f54b6b6c7b654, 0xce1eb7d0d06dd0b7, 0x3bd67eeded2aed7e,
+		0x2ee2dbcccc49ccdb, 0x2a68574242c64257, 0xb42cc29898b598c2, 0x49ed0ea4a4f1a40e,
+		0x5d75882828782888, 0xda86315c5ce45c31, 0x936b3ff8f815f83f, 0x44c2a486869786a4,
+	},
+	{
+		0x78c0d87818182818, 0xaf0526af23236523, 0xf97eb8f9c6c657c6, 0x6f13fb6fe8e825e8,
+		0xa14ccba187879487, 0x62a91162b8b8d5b8, 0x0508090501010301, 0x6e420d6e4f4fd14f,
+		0xeead9bee36365a36, 0x0459ff04a6a6f7a6, 0xbdde0cbdd2d26bd2, 0x06fb0e06f5f502f5,
+		0x80ef968079798b79, 0xce5f30ce6f6fb16f, 0xeffc6def9191ae91, 0x07aaf8075252f652,
+		0xfd2747fd6060a060, 0x76893576bcbcd9bc, 0xcdac37cd9b9bb09b, 0x8c048a8c8e8e8f8e,
+		0x1571d215a3a3f8a3, 0x3c606c3c0c0c140c, 0x8aff848a7b7b8d7b, 0xe1b580e135355f35,
+		0x69e8f5691d1d271d, 0x4753b347e0e03de0, 0xacf621acd7d764d7, 0xed5e9cedc2c25bc2,
+		0x966d43962e2e722e, 0x7a62297a4b4bdd4b, 0x21a35d21fefe1ffe, 0x1682d5165757f957,
+		0x41a8bd4115153f15, 0xb69fe8b677779977, 0xeba592eb37375937, 0x567b9e56e5e532e5,
+		0xd98c13d99f9fbc9f, 0x17d32317f0f00df0, 0x7f6a207f4a4ade4a, 0x959e4495dada73da,
+		0x25faa2255858e858, 0xca06cfcac9c946c9, 0x8d557c8d29297b29, 0x22505a220a0a1e0a,
+		0x4fe1504fb1b1ceb1, 0x1a69c91aa0a0fda0, 0xda7f14da6b6bbd6b, 0xab5cd9ab85859285,
+		0x73813c73bdbddabd, 0x34d28f345d5de75d, 0x5080905010103010, 0x03f30703f4f401f4,
+		0xc016ddc0cbcb40cb, 0xc6edd3c63e3e423e, 0x11282d1105050f05, 0xe61f78e66767a967,
+		0x53739753e4e431e4, 0xbb2502bb27276927, 0x583273584141c341, 0x9d2ca79d8b8b808b,
+		0x0151f601a7a7f4a7, 0x94cfb2947d7d877d, 0xfbdc49fb9595a295, 0x9f8e569fd8d875d8,
+		0x308b7030fbfb10fb, 0x7123cd71eeee2fee, 0x91c7bb917c7c847c, 0xe31771e36666aa66,
+		0x8ea67b8edddd7add, 0x4bb8af4b17173917, 0x460245464747c947, 0xdc841adc9e9ebf9e,
+		0xc51ed4c5caca43ca, 0x997558992d2d772d, 0x79912e79bfbfdcbf, 0x1b383f1b07070907,
+		0x2301ac23adadeaad, 0x2feab02f5a5aee5a, 0xb56cefb583839883, 0xff85b6ff33335533,
+		0xf23f5cf26363a563, 0x0a10120a02020602, 0x38399338aaaae3aa, 0xa8afdea871719371,
+		0xcf0ec6cfc8c845c8, 0x7dc8d17d19192b19, 0x70723b704949db49, 0x9a865f9ad9d976d9,
+		0x1dc3311df2f20bf2, 0x484ba848e3e338e3, 0x2ae2b92a5b5bed5b, 0x9234bc9288888588,
+		0xc8a43ec89a9ab39a, 0xbe2d0bbe26266a26, 0xfa8dbffa32325632, 0x4ae9594ab0b0cdb0,
+		0x6a1bf26ae9e926e9, 0x337877330f0f110f, 0xa6e633a6d5d562d5, 0xba74f4ba80809d80,
+		0x7c99277cbebedfbe, 0xde26ebdecdcd4acd, 0xe4bd89e434345c34, 0x757a32754848d848,
+		0x24ab5424ffff1cff, 0x8ff78d8f7a7a8e7a, 0xeaf464ea9090ad90, 0x3ec29d3e5f5fe15f,
+		0xa01d3da020206020, 0xd5670fd56868b868, 0x72d0ca721a1a2e1a, 0x2c19b72caeaeefae,
+		0x5ec97d5eb4b4c1b4, 0x199ace195454fc54, 0xe5ec7fe59393a893, 0xaa0d2faa22226622,
+		0xe90763e96464ac64, 0x12db2a12f1f10ef1, 0xa2bfcca273739573, 0x5a90825a12123612,
+		0x5d3a7a5d4040c040, 0x2840482808081808, 0xe85695e8c3c358c3, 0x7b33df7becec29ec,
+		0x90964d90dbdb70db, 0x1f61c01fa1a1fea1, 0x831c91838d8d8a8d, 0xc9f5c8c93d3d473d,
+		0xf1cc5bf19797a497, 0x0000000000000000, 0xd436f9d4cfcf4ccf, 0x87456e872b2b7d2b,
+		0xb397e1b376769a76, 0xb064e6b082829b82, 0xa9fe28a9d6d667d6, 0x77d8c3771b1b2d1b,
+		0x5bc1745bb5b5c2b5, 0x2911be29afafecaf, 0xdf771ddf6a6abe6a, 0x0dbaea0d5050f050,
+		0x4c12574c4545cf45, 0x18cb3818f3f308f3, 0xf09dadf030305030, 0x742bc474efef2cef,
+		0xc3e5dac33f3f413f, 0x1c92c71c5555ff55, 0x1079db10a2a2fba2, 0x6503e965eaea23ea,
+		0xec0f6aec6565af65, 0x68b90368babad3ba, 0x93654a932f2f712f, 0xe74e8ee7c0c05dc0,
+		0x81be6081dede7fde, 0x6ce0fc6c1c1c241c, 0x2ebb462efdfd1afd, 0x64521f644d4dd74d,
+		0xe0e476e09292ab92, 0xbc8ffabc75759f75, 0x1e30361e06060a06, 0x9824ae988a8a838a,
+		0x40f94b40b2b2cbb2, 0x59638559e6e637e6, 0x36707e360e0e120e, 0x63f8e7631f1f211f,
+		0xf73755f76262a662, 0xa3ee3aa3d4d461d4, 0x32298132a8a8e5a8, 0xf4c452f49696a796,
+		0x3a9b623af9f916f9, 0xf666a3f6c5c552c5, 0xb13510b125256f25, 0x20f2ab205959eb59,
+		0xae54d0ae84849184, 0xa7b7c5a772729672, 0xddd5ecdd39394b39, 0x615a16614c4cd44c,
+		0x3bca943b5e5ee25e, 0x85e79f8578788878, 0xd8dde5d838384838, 0x861498868c8c898c,
+		0xb2c617b2d1d16ed1, 0x0b41e40ba5a5f2a5, 0x4d43a14de2e23be2, 0xf82f4ef86161a361,
+		0x45f14245b3b3c8b3, 0xa51534a521216321, 0xd69408d69c9cb99c, 0x66f0ee661e1e221e,
+		0x522261524343c543, 0xfc76b1fcc7c754c7, 0x2bb34f2bfcfc19fc, 0x1420241404040c04,
+		0x08b2e3085151f351, 0xc7bc25c79999b699, 0xc44f22c46d6db76d, 0x396865390d0d170d,
+		0x35837935fafa13fa, 0x84b66984dfdf7cdf, 0x9bd7a99b7e7e827e, 0xb43d19b424246c24,
+		0xd7c5fed73b3b4d3b, 0x3d319a3dababe0ab, 0xd13ef0d1cece4fce, 0x5588995511113311,
+		0x890c83898f8f8c8f, 0x6b4a046b4e4ed24e, 0x51d16651b7b7c4b7, 0x600be060ebeb20eb,
+		0xccfdc1cc3c3c443c, 0xbf7cfdbf81819e81, 0xfed440fe9494a194, 0x0ceb1c0cf7f704f7,
+		0x67a11867b9b9d6b9, 0x5f988b5f13133513, 0x9c7d519c2c2c742c, 0xb8d605b8d3d368d3,
+		0x5c6b8c5ce7e734e7, 0xcb5739cb6e6eb26e, 0xf36eaaf3c4c451c4, 0x0f181b0f03030503,
+		0x138adc135656fa56, 0x491a5e494444cc44, 0x9edfa09e7f7f817f, 0x37218837a9a9e6a9,
+		0x824d67822a2a7e2a, 0x6db10a6dbbbbd0bb, 0xe24687e2c1c15ec1, 0x02a2f1025353f553,
+		0x8bae728bdcdc79dc, 0x275853270b0b1d0b, 0xd39c01d39d9dba9d, 0xc1472bc16c6cb46c,
+		0xf595a4f531315331, 0xb987f3b974749c74, 0x09e31509f6f607f6, 0x430a4c434646ca46,
+		0x2609a526acace9ac, 0x973cb59789898689, 0x44a0b44414143c14, 0x425bba42e1e13ee1,
+		0x4eb0a64e16163a16, 0xd2cdf7d23a3a4e3a, 0xd06f06d06969bb69, 0x2d48412d09091b09,
+		0xada7d7ad70709070, 0x54d96f54b6b6c7b6, 0xb7ce1eb7d0d06dd0, 0x7e3bd67eeded2aed,
+		0xdb2ee2dbcccc49cc, 0x572a68574242c642, 0xc2b42cc29898b598, 0x0e49ed0ea4a4f1a4,
+		0x885d758828287828, 0x31da86315c5ce45c, 0x3f936b3ff8f815f8, 0xa444c2a486869786,
+	},
+	{
+		0x1878c0d878181828, 0x23af0526af232365, 0xc6f97eb8f9c6c657, 0xe86f13fb6fe8e825,
+		0x87a14ccba1878794, 0xb862a91162b8b8d5, 0x0105080905010103, 0x4f6e420d6e4f4fd1,
+		0x36eead9bee36365a, 0xa60459ff04a6a6f7, 0xd2bdde0cbdd2d26b, 0xf506fb0e06f5f502,
+		0x7980ef968079798b, 0x6fce5f30ce6f6fb1, 0x91effc6def9191ae, 0x5207aaf8075252f6,
+		0x60fd2747fd6060a0, 0xbc76893576bcbcd9, 0x9bcdac37cd9b9bb0, 0x8e8c048a8c8e8e8f,
+		0xa31571d215a3a3f8, 0x0c3c606c3c0c0c14, 0x7b8aff848a7b7b8d, 0x35e1b580e135355f,
+		0x1d69e8f5691d1d27, 0xe04753b347e0e03d, 0xd7acf621acd7d764, 0xc2ed5e9cedc2c25b,
+		0x2e966d43962e2e72, 0x4b7a62297a4b4bdd, 0xfe21a35d21fefe1f, 0x571682d5165757f9,
+		0x1541a8bd4115153f, 0x77b69fe8b6777799, 0x37eba592eb373759, 0xe5567b9e56e5e532,
+		0x9fd98c13d99f9fbc, 0xf017d32317f0f00d, 0x4a7f6a207f4a4ade, 0xda959e4495dada73,
+		0x5825faa2255858e8, 0xc9ca06cfcac9c946, 0x298d557c8d29297b, 0x0a22505a220a0a1e,
+		0xb14fe1504fb1b1ce, 0xa01a69c91aa0a0fd, 0x6bda7f14da6b6bbd, 0x85ab5cd9ab858592,
+		0xbd73813c73bdbdda, 0x5d34d28f345d5de7, 0x1050809050101030, 0xf403f30703f4f401,
+		0xcbc016ddc0cbcb40, 0x3ec6edd3c63e3e42, 0x0511282d1105050f, 0x67e61f78e66767a9,
+		0xe453739753e4e431, 0x27bb2502bb272769, 0x41583273584141c3, 0x8b9d2ca79d8b8b80,
+		0xa70151f601a7a7f4, 0x7d94cfb2947d7d87, 0x95fbdc49fb9595a2, 0xd89f8e569fd8d875,
+		0xfb308b7030fbfb10, 0xee7123cd71eeee2f, 0x7c91c7bb917c7c84, 0x66e31771e36666aa,
+		0xdd8ea67b8edddd7a, 0x174bb8af4b171739, 0x47460245464747c9, 0x9edc841adc9e9ebf,
+		0xcac51ed4c5caca43, 0x2d997558992d2d77, 0xbf79912e79bfbfdc, 0x071b383f1b070709,
+		0xad2301ac23adadea, 0x5a2feab02f5a5aee, 0x83b56cefb5838398, 0x33ff85b6ff333355,
+		0x63f23f5cf26363a5, 0x020a10120a020206, 0xaa38399338aaaae3, 0x71a8afdea8717193,
+		0xc8cf0ec6cfc8c845, 0x197dc8d17d19192b, 0x4970723b704949db, 0xd99a865f9ad9d976,
+		0xf21dc3311df2f20b, 0xe3484ba848e3e338, 0x5b2ae2b92a5b5bed, 0x889234bc92888885,
+		0x9ac8a43ec89a9ab3, 0x26be2d0bbe26266a, 0x32fa8dbffa323256, 0xb04ae9594ab0b0cd,
+		0xe96a1bf26ae9e926, 0x0f337877330f0f11, 0xd5a6e633a6d5d562, 0x80ba74f4ba80809d,
+		0xbe7c99277cbebedf, 0xcdde26ebdecdcd4a, 0x34e4bd89e434345c, 0x48757a32754848d8,
+		0xff24ab5424ffff1c, 0x7a8ff78d8f7a7a8e, 0x90eaf464ea9090ad, 0x5f3ec29d3e5f5fe1,
+		0x20a01d3da0202060, 0x68d5670fd56868b8, 0x1a72d0ca721a1a2e, 0xae2c19b72caeaeef,
+		0xb45ec97d5eb4b4c1, 0x54199ace195454fc, 0x93e5ec7fe59393a8, 0x22aa0d2faa222266,
+		0x64e90763e96464ac, 0xf112db2a12f1f10e, 0x73a2bfcca2737395, 0x125a90825a121236,
+		0x405d3a7a5d4040c0, 0x0828404828080818, 0xc3e85695e8c3c358, 0xec7b33df7becec29,
+		0xdb90964d90dbdb70, 0xa11f61c01fa1a1fe, 0x8d831c91838d8d8a, 0x3dc9f5c8c93d3d47,
+		0x97f1cc5bf19797a4, 0x0000000000000000, 0xcfd436f9d4cfcf4c, 0x2b87456e872b2b7d,
+		0x76b397e1b376769a, 0x82b064e6b082829b, 0xd6a9fe28a9d6d667, 0x1b77d8c3771b1b2d,
+		0xb55bc1745bb5b5c2, 0xaf2911be29afafec, 0x6adf771ddf6a6abe, 0x500dbaea0d5050f0,
+		0x454c12574c4545cf, 0xf318cb3818f3f308, 0x30f09dadf0303050, 0xef742bc474efef2c,
+		0x3fc3e5dac33f3f41, 0x551c92c71c5555ff, 0xa21079db10a2a2fb, 0xea6503e965eaea23,
+		0x65ec0f6aec6565af, 0xba68b90368babad3, 0x2f93654a932f2f71, 0xc0e74e8ee7c0c05d,
+		0xde81be6081dede7f, 0x1c6ce0fc6c1c1c24, 0xfd2ebb462efdfd1a, 0x4d64521f644d4dd7,
+		0x92e0e476e09292ab, 0x75bc8ffabc75759f, 0x061e30361e06060a, 0x8a9824ae988a8a83,
+		0xb240f94b40b2b2cb, 0xe659638559e6e637, 0x0e36707e360e0e12, 0x1f63f8e7631f1f21,
+		0x62f73755f76262a6, 0xd4a3ee3aa3d4d461, 0xa832298132a8a8e5, 0x96f4c452f49696a7,
+		0xf93a9b623af9f916, 0xc5f666a3f6c5c552, 0x25b13510b125256f, 0x5920f2ab205959eb,
+		0x84ae54d0ae848491, 0x72a7b7c5a7727296, 0x39ddd5ecdd39394b, 0x4c615a16614c4cd4,
+		0x5e3bca943b5e5ee2, 0x7885e79f85787888, 0x38d8dde5d8383848, 0x8c861498868c8c89,
+		0xd1b2c617b2d1d16e, 0xa50b41e40ba5a5f2, 0xe24d43a14de2e23b, 0x61f82f4ef86161a3,
+		0xb345f14245b3b3c8, 0x21a51534a5212163, 0x9cd69408d69c9cb9, 0x1e66f0ee661e1e22,
+		0x43522261524343c5, 0xc7fc76b1fcc7c754, 0xfc2bb34f2bfcfc19, 0x041420241404040c,
+		0x5108b2e3085151f3, 0x99c7bc25c79999b6, 0x6dc44f22c46d6db7, 0x0d396865390d0d17,
+		0xfa35837935fafa13, 0xdf84b66984dfdf7c, 0x7e9bd7a99b7e7e82, 0x24b43d19b424246c,
+		0x3bd7c5fed73b3b4d, 0xab3d319a3dababe0, 0xced13ef0d1cece4f, 0x1155889955111133,
+		0x8f890c83898f8f8c, 0x4e6b4a046b4e4ed2, 0xb751d16651b7b7c4, 0xeb600be060ebeb20,
+		0x3cccfdc1cc3c3c44, 0x81bf7cfdbf81819e, 0x94fed440fe9494a1, 0xf70ceb1c0cf7f704,
+		0xb967a11867b9b9d6, 0x135f988b5f131335, 0x2c9c7d519c2c2c74, 0xd3b8d605b8d3d368,
+		0xe75c6b8c5ce7e734, 0x6ecb5739cb6e6eb2, 0xc4f36eaaf3c4c451, 0x030f181b0f030305,
+		0x56138adc135656fa, 0x44491a5e494444cc, 0x7f9edfa09e7f7f81, 0xa937218837a9a9e6,
+		0x2a824d67822a2a7e, 0xbb6db10a6dbbbbd0, 0xc1e24687e2c1c15e, 0x5302a2f1025353f5,
+		0xdc8bae728bdcdc79, 0x0b275853270b0b1d, 0x9dd39c01d39d9dba, 0x6cc1472bc16c6cb4,
+		0x31f595a4f5313153, 0x74b987f3b974749c, 0xf609e31509f6f607, 0x46430a4c434646ca,
+		0xac2609a526acace9, 0x89973cb597898986, 0x1444a0b44414143c, 0xe1425bba42e1e13e,
+		0x164eb0a64e16163a, 0x3ad2cdf7d23a3a4e, 0x69d06f06d06969bb, 0x092d48412d09091b,
+		0x70ada7d7ad707090, 0xb654d96f54b6b6c7, 0xd0b7ce1eb7d0d06d, 0xed7e3bd67eeded2a,
+		0xccdb2ee2dbcccc49, 0x42572a68574242c6, 0x98c2b42cc29898b5, 0xa40e49ed0ea4a4f1,
+		0x28885d7588282878, 0x5c31da86315c5ce4, 0xf83f936b3ff8f815, 0x86a444c2a4868697,
+	},
+	{
+		0x281878c0d8781818, 0x6523af0526af2323, 0x57c6f97eb8f9c6c6, 0x25e86f13fb6fe8e8,
+		0x9487a14ccba18787, 0xd5b862a91162b8b8, 0x0301050809050101, 0xd14f6e420d6e4f4f,
+		0x5a36eead9bee3636, 0xf7a60459ff04a6a6, 0x6bd2bdde0cbdd2d2, 0x02f506fb0e06f5f5,
+		0x8b7980ef96807979, 0xb16fce5f30ce6f6f, 0xae91effc6def9191, 0xf65207aaf8075252,
+		0xa060fd2747fd6060, 0xd9bc76893576bcbc, 0xb09bcdac37cd9b9b, 0x8f8e8c048a8c8e8e,
+		0xf8a31571d215a3a3, 0x140c3c606c3c0c0c, 0x8d7b8aff848a7b7b, 0x5f35e1b580e13535,
+		0x271d69e8f5691d1d, 0x3de04753b347e0e0, 0x64d7acf621acd7d7, 0x5bc2ed5e9cedc2c2,
+		0x722e966d43962e2e, 0xdd4b7a62297a4b4b, 0x1ffe21a35d21fefe, 0xf9571682d5165757,
+		0x3f1541a8bd411515, 0x9977b69fe8b67777, 0x5937eba592eb3737, 0x32e5567b9e56e5e5,
+		0xbc9fd98c13d99f9f, 0x0df017d32317f0f0, 0xde4a7f6a207f4a4a, 0x73da959e4495dada,
+		0xe85825faa2255858, 0x46c9ca06cfcac9c9, 0x7b298d557c8d2929, 0x1e0a22505a220a0a,
+		0xceb14fe1504fb1b1, 0xfda01a69c91aa0a0, 0xbd6bda7f14da6b6b, 0x9285ab5cd9ab8585,
+		0xdabd73813c73bdbd, 0xe75d34d28f345d5d, 0x3010508090501010, 0x01f403f30703f4f4,
+		0x40cbc016ddc0cbcb, 0x423ec6edd3c63e3e, 0x0f0511282d110505, 0xa967e61f78e66767,
+		0x31e453739753e4e4, 0x6927bb2502bb2727, 0xc341583273584141, 0x808b9d2ca79d8b8b,
+		0xf4a70151f601a7a7, 0x877d94cfb2947d7d, 0xa295fbdc49fb9595, 0x75d89f8e569fd8d8,
+		0x10fb308b7030fbfb, 0x2fee7123cd71eeee, 0x847c91c7bb917c7c, 0xaa66e31771e36666,
+		0x7add8ea67b8edddd, 0x39174bb8af4b1717, 0xc947460245464747, 0xbf9edc841adc9e9e,
+		0x43cac51ed4c5caca, 0x772d997558992d2d, 0xdcbf79912e79bfbf, 0x09071b383f1b0707,
+		0xeaad2301ac23adad, 0xee5a2feab02f5a5a, 0x9883b56cefb58383, 0x5533ff85b6ff3333,
+		0xa563f23f5cf26363, 0x06020a10120a0202, 0xe3aa38399338aaaa, 0x9371a8afdea87171,
+		0x45c8cf0ec6cfc8c8, 0x2b197dc8d17d1919, 0xdb4970723b704949, 0x76d99a865f9ad9d9,
+		0x0bf21dc3311df2f2, 0x38e3484ba848e3e3, 0xed5b2ae2b92a5b5b, 0x85889234bc928888,
+		0xb39ac8a43ec89a9a, 0x6a26be2d0bbe2626, 0x5632fa8dbffa3232, 0xcdb04ae9594ab0b0,
+		0x26e96a1bf26ae9e9, 0x110f337877330f0f, 0x62d5a6e633a6d5d5, 0x9d80ba74f4ba8080,
+		0xdfbe7c99277cbebe, 0x4acdde26ebdecdcd, 0x5c34e4bd89e43434, 0xd848757a32754848,
+		0x1cff24ab5424ffff, 0x8e7a8ff78d8f7a7a, 0xad90eaf464ea9090, 0xe15f3ec29d3e5f5f,
+		0x6020a01d3da02020, 0xb868d5670fd56868, 0x2e1a72d0ca721a1a, 0xefae2c19b72caeae,
+		0xc1b45ec97d5eb4b4, 0xfc54199ace195454, 0xa893e5ec7fe59393, 0x6622aa0d2faa2222,
+		0xac64e90763e96464, 0x0ef112db2a12f1f1, 0x9573a2bfcca27373, 0x36125a90825a1212,
+		0xc0405d3a7a5d4040, 0x1808284048280808, 0x58c3e85695e8c3c3, 0x29ec7b33df7becec,
+		0x70db90964d90dbdb, 0xfea11f61c01fa1a1, 0x8a8d831c91838d8d, 0x473dc9f5c8c93d3d,
+		0xa497f1cc5bf19797, 0x0000000000000000, 0x4ccfd436f9d4cfcf, 0x7d2b87456e872b2b,
+		0x9a76b397e1b37676, 0x9b82b064e6b08282, 0x67d6a9fe28a9d6d6, 0x2d1b77d8c3771b1b,
+		0xc2b55bc1745bb5b5, 0xecaf2911be29afaf, 0xbe6adf771ddf6a6a, 0xf0500dbaea0d5050,
+		0xcf454c12574c4545, 0x08f318cb3818f3f3, 0x5030f09dadf03030, 0x2cef742bc474efef,
+		0x413fc3e5dac33f3f, 0xff551c92c71c5555, 0xfba21079db10a2a2, 0x23ea6503e965eaea,
+		0xaf65ec0f6aec6565, 0xd3ba68b90368baba, 0x712f93654a932f2f, 0x5dc0e74e8ee7c0c0,
+		0x7fde81be6081dede, 0x241c6ce0fc6c1c1c, 0x1afd2ebb462efdfd, 0xd74d64521f644d4d,
+		0xab92e0e476e09292, 0x9f75bc8ffabc7575, 0x0a061e30361e0606, 0x838a9824ae988a8a,
+		0xcbb240f94b40b2b2, 0x37e659638559e6e6, 0x120e36707e360e0e, 0x211f63f8e7631f1f,
+		0xa662f73755f76262, 0x61d4a3ee3aa3d4d4, 0xe5a832298132a8a8, 0xa796f4c452f49696,
+		0x16f93a9b623af9f9, 0x52c5f666a3f6c5c5, 0x6f25b13510b12525, 0xeb5920f2ab205959,
+		0x9184ae54d0ae8484, 0x9672a7b7c5a77272, 0x4b39ddd5ecdd3939, 0xd44c615a16614c4c,
+		0xe25e3bca943b5e5e, 0x887885e79f857878, 0x4838d8dde5d83838, 0x898c861498868c8c,
+		0x6ed1b2c617b2d1d1, 0xf2a50b41e40ba5a5, 0x3be24d43a14de2e2, 0xa361f82f4ef86161,
+		0xc8b345f14245b3b3, 0x6321a51534a52121, 0xb99cd69408d69c9c, 0x221e66f0ee661e1e,
+		0xc543522261524343, 0x54c7fc76b1fcc7c7, 0x19fc2bb34f2bfcfc, 0x0c04142024140404,
+		0xf35108b2e3085151, 0xb699c7bc25c79999, 0xb76dc44f22c46d6d, 0x170d396865390d0d,
+		0x13fa35837935fafa, 0x7cdf84b66984dfdf, 0x827e9bd7a99b7e7e, 0x6c24b43d19b42424,
+		0x4d3bd7c5fed73b3b, 0xe0ab3d319a3dabab, 0x4fced13ef0d1cece, 0x3311558899551111,
+		0x8c8f890c83898f8f, 0xd24e6b4a046b4e4e, 0xc4b751d16651b7b7, 0x20eb600be060ebeb,
+		0x443cccfdc1cc3c3c, 0x9e81bf7cfdbf8181, 0xa194fed440fe9494, 0x04f70ceb1c0cf7f7,
+		0xd6b967a11867b9b9, 0x35135f988b5f1313, 0x742c9c7d519c2c2c, 0x68d3b8d605b8d3d3,
+		0x34e75c6b8c5ce7e7, 0xb26ecb5739cb6e6e, 0x51c4f36eaaf3c4c4, 0x05030f181b0f0303,
+		0xfa56138adc135656, 0xcc44491a5e494444, 0x817f9edfa09e7f7f, 0xe6a937218837a9a9,
+		0x7e2a824d67822a2a, 0xd0bb6db10a6dbbbb, 0x5ec1e24687e2c1c1, 0xf55302a2f1025353,
+		0x79dc8bae728bdcdc, 0x1d0b275853270b0b, 0xba9dd39c01d39d9d, 0xb46cc1472bc16c6c,
+		0x5331f595a4f53131, 0x9c74b987f3b97474, 0x07f609e31509f6f6, 0xca46430a4c434646,
+		0xe9ac2609a526acac, 0x8689973cb5978989, 0x3c1444a0b4441414, 0x3ee1425bba42e1e1,
+		0x3a164eb0a64e1616, 0x4e3ad2cdf7d23a3a, 0xbb69d06f06d06969, 0x1b092d48412d0909,
+		0x9070ada7d7ad7070, 0xc7b654d96f54b6b6, 0x6dd0b7ce1eb7d0d0, 0x2aed7e3bd67eeded,
+		0x49ccdb2ee2dbcccc, 0xc642572a68574242, 0xb598c2b42cc29898, 0xf1a40e49ed0ea4a4,
+		0x7828885d75882828, 0xe45c31da86315c5c, 0x15f83f936b3ff8f8, 0x9786a444c2a48686,
+	},
+	{
+		0x18281878c0d87818, 0x236523af0526af23, 0xc657c6f97eb8f9c6, 0xe825e86f13fb6fe8,
+		0x879487a14ccba187, 0xb8d5b862a91162b8, 0x0103010508090501, 0x4fd14f6e420d6e4f,
+		0x365a36eead9bee36, 0xa6f7a60459ff04a6, 0xd26bd2bdde0cbdd2, 0xf502f506fb0e06f5,
+		0x798b7980ef968079, 0x6fb16fce5f30ce6f, 0x91ae91effc6def91, 0x52f65207aaf80752,
+		0x60a060fd2747fd60, 0xbcd9bc76893576bc, 0x9bb09bcdac37cd9b, 0x8e8f8e8c048a8c8e,
+		0xa3f8a31571d215a3, 0x0c140c3c606c3c0c, 0x7b8d7b8aff848a7b, 0x355f35e1b580e135,
+		0x1d271d69e8f5691d, 0xe03de04753b347e0, 0xd764d7acf621acd7, 0xc25bc2ed5e9cedc2,
+		0x2e722e966d43962e, 0x4bdd4b7a62297a4b, 0xfe1ffe21a35d21fe, 0x57f9571682d51657,
+		0x153f1541a8bd4115, 0x779977b69fe8b677, 0x375937eba592eb37, 0xe532e5567b9e56e5,
+		0x9fbc9fd98c13d99f, 0xf00df017d32317f0, 0x4ade4a7f6a207f4a, 0xda73da959e4495da,
+		0x58e85825faa22558, 0xc946c9ca06cfcac9, 0x297b298d557c8d29, 0x0a1e0a22505a220a,
+		0xb1ceb14fe1504fb1, 0xa0fda01a69c91aa0, 0x6bbd6bda7f14da6b, 0x859285ab5cd9ab85,
+		0xbddabd73813c73bd, 0x5de75d34d28f345d, 0x1030105080905010, 0xf401f403f30703f4,
+		0xcb40cbc016ddc0cb, 0x3e423ec6edd3c63e, 0x050f0511282d1105, 0x67a967e61f78e667,
+		0xe431e453739753e4, 0x276927bb2502bb27, 0x41c3415832735841, 0x8b808b9d2ca79d8b,
+		0xa7f4a70151f601a7, 0x7d877d94cfb2947d, 0x95a295fbdc49fb95, 0xd875d89f8e569fd8,
+		0xfb10fb308b7030fb, 0xee2fee7123cd71ee, 0x7c847c91c7bb917c, 0x66aa66e31771e366,
+		0xdd7add8ea67b8edd, 0x1739174bb8af4b17, 0x47c9474602454647, 0x9ebf9edc841adc9e,
+		0xca43cac51ed4c5ca, 0x2d772d997558992d, 0xbfdcbf79912e79bf, 0x0709071b383f1b07,
+		0xadeaad2301ac23ad, 0x5aee5a2feab02f5a, 0x839883b56cefb583, 0x335533ff85b6ff33,
+		0x63a563f23f5cf263, 0x0206020a10120a02, 0xaae3aa38399338aa, 0x719371a8afdea871,
+		0xc845c8cf0ec6cfc8, 0x192b197dc8d17d19, 0x49db4970723b7049, 0xd976d99a865f9ad9,
+		0xf20bf21dc3311df2, 0xe338e3484ba848e3, 0x5bed5b2ae2b92a5b, 0x8885889234bc9288,
+		0x9ab39ac8a43ec89a, 0x266a26be2d0bbe26, 0x325632fa8dbffa32, 0xb0cdb04ae9594ab0,
+		0xe926e96a1bf26ae9, 0x0f110f337877330f, 0xd562d5a6e633a6d5, 0x809d80ba74f4ba80,
+		0xbedfbe7c99277cbe, 0xcd4acdde26ebdecd, 0x345c34e4bd89e434, 0x48d848757a327548,
+		0xff1cff24ab5424ff, 0x7a8e7a8ff78d8f7a, 0x90ad90eaf464ea90, 0x5fe15f3ec29d3e5f,
+		0x206020a01d3da020, 0x68b868d5670fd568, 0x1a2e1a72d0ca721a, 0xaeefae2c19b72cae,
+		0xb4c1b45ec97d5eb4, 0x54fc54199ace1954, 0x93a893e5ec7fe593, 0x226622aa0d2faa22,
+		0x64ac64e90763e964, 0xf10ef112db2a12f1, 0x739573a2bfcca273, 0x1236125a90825a12,
+		0x40c0405d3a7a5d40, 0x0818082840482808, 0xc358c3e85695e8c3, 0xec29ec7b33df7bec,
+		0xdb70db90964d90db, 0xa1fea11f61c01fa1, 0x8d8a8d831c91838d, 0x3d473dc9f5c8c93d,
+		0x97a497f1cc5bf197, 0x0000000000000000, 0xcf4ccfd436f9d4cf, 0x2b7d2b87456e872b,
+		0x769a76b397e1b376, 0x829b82b064e6b082, 0xd667d6a9fe28a9d6, 0x1b2d1b77d8c3771b,
+		0xb5c2b55bc1745bb5, 0xafecaf2911be29af, 0x6abe6adf771ddf6a, 0x50f0500dbaea0d50,
+		0x45cf454c12574c45, 0xf308f318cb3818f3, 0x305030f09dadf030, 0xef2cef742bc474ef,
+		0x3f413fc3e5dac33f, 0x55ff551c92c71c55, 0xa2fba21079db10a2, 0xea23ea6503e965ea,
+		0x65af65ec0f6aec65, 0xbad3ba68b90368ba, 0x2f712f93654a932f, 0xc05dc0e74e8ee7c0,
+		0xde7fde81be6081de, 0x1c241c6ce0fc6c1c, 0xfd1afd2ebb462efd, 0x4dd74d64521f644d,
+		0x92ab92e0e476e092, 0x759f75bc8ffabc75, 0x060a061e30361e06, 0x8a838a9824ae988a,
+		0xb2cbb240f94b40b2, 0xe637e659638559e6, 0x0e120e36707e360e, 0x1f211f63f8e7631f,
+		0x62a662f73755f762, 0xd461d4a3ee3aa3d4, 0xa8e5a832298132a8, 0x96a796f4c452f496,
+		0xf916f93a9b623af9, 0xc552c5f666a3f6c5, 0x256f25b13510b125, 0x59eb5920f2ab2059,
+		0x849184ae54d0ae84, 0x729672a7b7c5a772, 0x394b39ddd5ecdd39, 0x4cd44c615a16614c,
+		0x5ee25e3bca943b5e, 0x78887885e79f8578, 0x384838d8dde5d838, 0x8c898c861498868c,
+		0xd16ed1b2c617b2d1, 0xa5f2a50b41e40ba5, 0xe23be24d43a14de2, 0x61a361f82f4ef861,
+		0xb3c8b345f14245b3, 0x216321a51534a521, 0x9cb99cd69408d69c, 0x1e221e66f0ee661e,
+		0x43c5435222615243, 0xc754c7fc76b1fcc7, 0xfc19fc2bb34f2bfc, 0x040c041420241404,
+		0x51f35108b2e30851, 0x99b699c7bc25c799, 0x6db76dc44f22c46d, 0x0d170d396865390d,
+		0xfa13fa35837935fa, 0xdf7cdf84b66984df, 0x7e827e9bd7a99b7e, 0x246c24b43d19b424,
+		0x3b4d3bd7c5fed73b, 0xabe0ab3d319a3dab, 0xce4fced13ef0d1ce, 0x1133115588995511,
+		0x8f8c8f890c83898f, 0x4ed24e6b4a046b4e, 0xb7c4b751d16651b7, 0xeb20eb600be060eb,
+		0x3c443cccfdc1cc3c, 0x819e81bf7cfdbf81, 0x94a194fed440fe94, 0xf704f70ceb1c0cf7,
+		0xb9d6b967a11867b9, 0x1335135f988b5f13, 0x2c742c9c7d519c2c, 0xd368d3b8d605b8d3,
+		0xe734e75c6b8c5ce7, 0x6eb26ecb5739cb6e, 0xc451c4f36eaaf3c4, 0x0305030f181b0f03,
+		0x56fa56138adc1356, 0x44cc44491a5e4944, 0x7f817f9edfa09e7f, 0xa9e6a937218837a9,
+		0x2a7e2a824d67822a, 0xbbd0bb6db10a6dbb, 0xc15ec1e24687e2c1, 0x53f55302a2f10253,
+		0xdc79dc8bae728bdc, 0x0b1d0b275853270b, 0x9dba9dd39c01d39d, 0x6cb46cc1472bc16c,
+		0x315331f595a4f531, 0x749c74b987f3b974, 0xf607f609e31509f6, 0x46ca46430a4c4346,
+		0xace9ac2609a526ac, 0x898689973cb59789, 0x143c1444a0b44414, 0xe13ee1425bba42e1,
+		0x163a164eb0a64e16, 0x3a4e3ad2cdf7d23a, 0x69bb69d06f06d069, 0x091b092d48412d09,
+		0x709070ada7d7ad70, 0xb6c7b654d96f54b6, 0xd06dd0b7ce1eb7d0, 0xed2aed7e3bd67eed,
+		0xcc49ccdb2ee2dbcc, 0x42c642572a685742, 0x98b598c2b42cc298, 0xa4f1a40e49ed0ea4,
+		0x287828885d758828, 0x5ce45c31da86315c, 0xf815f83f936b3ff8, 0x869786a444c2a486,
+	},
+}
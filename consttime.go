@@ -0,0 +1,118 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// variantConstantTime is the final whirlpool computed without lookup tables.
+// It shares the marshaled state format of variantFinal.
+var variantConstantTime = variant{
+	c:         variantFinal.c,
+	rc:        &rc,
+	size:      digestBytes,
+	magic:     magic,
+	constTime: true,
+}
+
+// NewConstantTime returns a new hash.Hash computing the whirlpool checksum
+// without indexing memory by secret data, so that it does not leak through
+// cache timing when the hashed data is a key. It is roughly thirty times
+// slower than New on amd64. Pass it to hmac.New, PBKDF2, VeraCryptHeaderKey
+// or the hkdf package in place of New to make them constant time as well.
+//
+// Its marshaled states are interchangeable with those of New.
+func NewConstantTime() hash.Hash {
+	return &whirlpool{v: &variantConstantTime}
+}
+
+// The mini-boxes of the S-box, packed so that entry x is in bits 4x to 4x+3.
+const (
+	miniBoxE    = 0x052a478e3f6dc9b1
+	miniBoxEinv = 0x68431c29a5eb7d0f
+	miniBoxR    = 0x0152a836f94edbc7
+)
+
+// nibble returns entry x < 16 of a packed mini-box. It picks the half holding
+// the entry with a mask so that even 32-bit platforms shift without branching.
+func nibble(box uint64, x byte) byte {
+	lo, hi := uint32(box), uint32(box>>32)
+	h := lo ^ (lo^hi)&-uint32(x>>3)
+	return byte(h>>(4*(x&7))) & 0xf
+}
+
+// sboxConstantTime computes the S-box from the E, E^-1 and R mini-boxes.
+func sboxConstantTime(u byte) byte {
+	a, b := nibble(miniBoxE, u>>4), nibble(miniBoxEinv, u&0xf)
+	r := nibble(miniBoxR, a^b)
+	return nibble(miniBoxE, a^r)<<4 | nibble(miniBoxEinv, b^r)
+}
+
+// xtime multiplies each byte of x by 2 in GF(2^8).
+func xtime(x uint64) uint64 {
+	return (x&0x7f7f7f7f7f7f7f7f)<<1 ^ (x>>7&0x0101010101010101)*0x1d
+}
+
+// mixRow multiplies a row by the circulant matrix cir(1, 1, 4, 1, 8, 5, 2, 9).
+// Column j of the result sums the coefficient of each diagonal d times byte
+// j-d of the row, so the row rotated by d bytes is grouped by coefficient and
+// the products are computed with Horner's rule.
+func mixRow(x uint64) uint64 {
+	r := func(d int) uint64 { return bits.RotateLeft64(x, -8*d) }
+	ones := r(0) ^ r(1) ^ r(3) ^ r(5) ^ r(7)
+	twos := r(6)
+	fours := r(2) ^ r(5)
+	eights := r(4) ^ r(7)
+	return ones ^ xtime(twos^xtime(fours^xtime(eights)))
+}
+
+// roundConstantTime computes θπγ(x), one round of W without the key addition.
+func roundConstantTime(x *[8]uint64) (L [8]uint64) {
+	for i := 0; i < 8; i++ {
+		var y uint64
+		for t := 0; t < 8; t++ {
+			y = y<<8 | uint64(sboxConstantTime(byte(x[(i-t)&7]>>(56-8*uint(t)))))
+		}
+		L[i] = mixRow(y)
+	}
+	return
+}
+
+// transformConstantTime compresses a block into the hash state without lookup
+// tables.
+func (w *whirlpool) transformConstantTime(buf *[wblockBytes]byte) {
+	var (
+		K     = w.hash // The round key.
+		block [8]uint64
+		state [8]uint64
+	)
+
+	// Map the buffer to a block and compute the cipher state.
+	for i := range block {
+		block[i] = binary.BigEndian.Uint64(buf[8*i:])
+		state[i] = block[i] ^ K[i]
+	}
+
+	// Iterate over all the rounds.
+	for r := 1; r <= rounds; r++ {
+		// Compute the round key.
+		K = roundConstantTime(&K)
+		K[0] ^= w.v.rc[r]
+
+		// Apply the round transformation.
+		state = roundConstantTime(&state)
+		for i := range state {
+			state[i] ^= K[i]
+		}
+	}
+
+	// Apply the Miyaguchi-Preneel compression function.
+	for i := range w.hash {
+		w.hash[i] ^= state[i] ^ block[i]
+	}
+}
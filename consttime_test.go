@@ -0,0 +1,84 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"encoding"
+	"hash"
+	"math/rand"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// implementations lists the constructors accepted by PBKDF2 and hmac.New.
+// The slow ones run only their cheapest cases under -short.
+var implementations = []struct {
+	name string
+	new  func() hash.Hash
+	slow bool
+}{
+	{"New", whirlpool.New, false},
+	{"NewConstantTime", whirlpool.NewConstantTime, true},
+}
+
+func TestGoldenConstantTime(t *testing.T) {
+	testGoldenVariant(t, "whirlpoolCT", whirlpool.NewConstantTime, golden)
+}
+
+// TestConstantTime compares NewConstantTime against New on random messages,
+// including ones whose length is not a multiple of 8 bits.
+func TestConstantTime(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		msg := make([]byte, rnd.Intn(3*whirlpool.BlockSize))
+		rnd.Read(msg)
+		nbits := uint64(len(msg)) * 8
+		if len(msg) > 0 {
+			nbits -= uint64(rnd.Intn(8))
+		}
+
+		want := whirlpool.New().(whirlpool.BitHash)
+		want.WriteBits(msg, nbits)
+		c := whirlpool.NewConstantTime().(whirlpool.BitHash)
+		c.WriteBits(msg, nbits)
+		if s, w := c.Sum(nil), want.Sum(nil); !bytes.Equal(s, w) {
+			t.Fatalf("whirlpoolCT(%x, %d) = %x want %x", msg, nbits, s, w)
+		}
+	}
+}
+
+// TestConstantTimeMarshal checks that a state saved by New can be resumed
+// by NewConstantTime.
+func TestConstantTimeMarshal(t *testing.T) {
+	h := whirlpool.New()
+	h.Write([]byte("The quick brown fox "))
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := whirlpool.NewConstantTime()
+	if err := c.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		t.Fatal(err)
+	}
+	h.Write([]byte("jumps over the lazy dog"))
+	c.Write([]byte("jumps over the lazy dog"))
+	if s, w := c.Sum(nil), h.Sum(nil); !bytes.Equal(s, w) {
+		t.Fatalf("whirlpoolCT = %x want %x", s, w)
+	}
+}
+
+func BenchmarkHashConstantTime1K(b *testing.B) {
+	h := whirlpool.NewConstantTime()
+	sum := make([]byte, 0, h.Size())
+	b.SetBytes(1024)
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(buf[:1024])
+		h.Sum(sum[:0])
+	}
+}
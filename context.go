@@ -0,0 +1,149 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"strconv"
+)
+
+// DefaultProgressInterval is the number of bytes between progress reports
+// when SumOptions leaves it unset.
+const DefaultProgressInterval = 1 << 20
+
+// SumOptions configures SumReaderContext. The zero value starts a new hash
+// without progress reports.
+type SumOptions struct {
+	// Progress, if set, is called with the number of bytes hashed so far,
+	// including those hashed before State was saved, each time the count
+	// reaches a multiple of ProgressInterval, and once at EOF.
+	Progress func(n int64)
+
+	// ProgressInterval is the number of bytes between calls to Progress;
+	// DefaultProgressInterval if zero or negative.
+	ProgressInterval int64
+
+	// State, if set, is the state of an interrupted hash to resume, as
+	// returned in InterruptedError. The reader must continue where the
+	// interrupted call stopped reading.
+	State []byte
+}
+
+// InterruptedError is returned by SumReaderContext when its context is done
+// before the end of the data.
+type InterruptedError struct {
+	N     int64  // Bytes hashed so far, including those of a resumed State.
+	State []byte // Marshaled state to resume from with SumOptions.State.
+	Err   error  // Error of the context.
+}
+
+func (e *InterruptedError) Error() string {
+	return "whirlpool: interrupted after " + strconv.FormatInt(e.N, 10) + " bytes: " + e.Err.Error()
+}
+
+func (e *InterruptedError) Unwrap() error {
+	return e.Err
+}
+
+// SumReaderContext is like SumReader, but stops early when ctx is done and
+// can report its progress. It returns the number of bytes read from r by
+// this call and hashed. The context is checked between blocks; when it is
+// done the returned error is an *InterruptedError holding the total number
+// of bytes hashed and a state from which a later call can resume.
+//
+// Data is read from r up to 32 KiB at a time. If the context is done part
+// way through a read, the bytes not yet hashed are given back by seeking r
+// if it is an io.Seeker, so that a resumed call reads them again. Otherwise
+// they are hashed before returning.
+func SumReaderContext(ctx context.Context, r io.Reader, opts *SumOptions) ([Size]byte, int64, error) {
+	var o SumOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.ProgressInterval <= 0 {
+		o.ProgressInterval = DefaultProgressInterval
+	}
+
+	w := &whirlpool{v: &variantFinal}
+	if o.State != nil {
+		if err := w.UnmarshalBinary(o.State); err != nil {
+			return [Size]byte{}, 0, err
+		}
+	}
+
+	scratch := scratchPool.Get().(*[scratchSize]byte)
+	defer scratchPool.Put(scratch)
+
+	var (
+		n        int64             // Bytes hashed by this call.
+		total    = w.bytesHashed() // Bytes hashed so far.
+		reported = int64(-1)       // Count last passed to Progress, none yet.
+		done     = ctx.Done()
+		stop     bool // The context is done but the read is still hashed.
+	)
+	next := (total/o.ProgressInterval + 1) * o.ProgressInterval // Next count to report.
+	interrupted := func() error {
+		state, _ := w.MarshalBinary()
+		return &InterruptedError{N: total, State: state, Err: ctx.Err()}
+	}
+	for {
+		if ctx.Err() != nil {
+			return [Size]byte{}, n, interrupted()
+		}
+
+		m, err := r.Read(scratch[:])
+		for p := scratch[:m]; len(p) > 0; {
+			select {
+			case <-done:
+				if s, ok := r.(io.Seeker); ok {
+					if _, err := s.Seek(-int64(len(p)), io.SeekCurrent); err == nil {
+						return [Size]byte{}, n, interrupted()
+					}
+				}
+				stop, done = true, nil
+			default:
+			}
+
+			// Hash up to the end of the block, or of the progress interval.
+			c := wblockBytes - w.bufferPos
+			if c > len(p) {
+				c = len(p)
+			}
+			if o.Progress != nil && int64(c) > next-total {
+				c = int(next - total)
+			}
+			w.Write(p[:c])
+			p = p[c:]
+			n += int64(c)
+			total += int64(c)
+			if o.Progress != nil && total == next {
+				o.Progress(total)
+				reported = total
+				next += o.ProgressInterval
+			}
+		}
+		if stop {
+			return [Size]byte{}, n, interrupted()
+		}
+
+		if err == io.EOF {
+			if o.Progress != nil && reported != total {
+				o.Progress(total)
+			}
+			return w.checkSum(), n, nil
+		}
+		if err != nil {
+			return [Size]byte{}, n, err
+		}
+	}
+}
+
+// bytesHashed returns the number of whole bytes hashed so far.
+func (w *whirlpool) bytesHashed() int64 {
+	bits := binary.BigEndian.Uint64(w.bitLength[lengthBytes-8:])
+	return int64(bits>>3 | uint64(w.bitLength[lengthBytes-9]&7)<<61)
+}
@@ -0,0 +1,225 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+func TestGoldenSumReaderContext(t *testing.T) {
+	for _, g := range golden {
+		sum, n, err := whirlpool.SumReaderContext(context.Background(), strings.NewReader(g.in), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(len(g.in)) {
+			t.Fatalf("SumReaderContext(%s) read %d bytes want %d", g.in, n, len(g.in))
+		}
+		if s := fmt.Sprintf("%X", sum); s != g.out {
+			t.Fatalf("SumReaderContext(%s) = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+func TestSumReaderContextProgress(t *testing.T) {
+	var got []int64
+	opts := &whirlpool.SumOptions{
+		Progress:         func(n int64) { got = append(got, n) },
+		ProgressInterval: 1000,
+	}
+	r := iotest.OneByteReader(bytes.NewReader(make([]byte, 3500)))
+	if _, _, err := whirlpool.SumReaderContext(context.Background(), r, opts); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int64{1000, 2000, 3000, 3500}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("progress = %v want %v", got, want)
+	}
+}
+
+// TestSumReaderContextLargeReads checks that progress is reported once per
+// multiple of the interval when a single read crosses many of them.
+func TestSumReaderContextLargeReads(t *testing.T) {
+	const size = 3050
+	for _, interval := range []int64{1, 7, 64, 100, 1000, 5000} {
+		var got []int64
+		opts := &whirlpool.SumOptions{
+			Progress:         func(n int64) { got = append(got, n) },
+			ProgressInterval: interval,
+		}
+		data := make([]byte, size)
+		sum, _, err := whirlpool.SumReaderContext(context.Background(), bytes.NewReader(data), opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := whirlpool.Sum(data); sum != want {
+			t.Fatalf("SumReaderContext(interval %d) = %X want %X", interval, sum, want)
+		}
+
+		var want []int64
+		for n := interval; n <= size; n += interval {
+			want = append(want, n)
+		}
+		if size%interval != 0 {
+			want = append(want, size)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("progress(interval %d) = %v want %v", interval, got, want)
+		}
+	}
+}
+
+// TestSumReaderContextSeek cancels a hash in the middle of a large read and
+// checks that it stops at the next block, giving the unhashed bytes back to
+// the seekable reader.
+func TestSumReaderContextSeek(t *testing.T) {
+	data := make([]byte, 300000)
+	for i := range data {
+		data[i] = byte(i * 13)
+	}
+	want := whirlpool.Sum(data)
+
+	r := bytes.NewReader(data)
+	ctx, cancel := context.WithCancel(context.Background())
+	opts := &whirlpool.SumOptions{
+		Progress:         func(n int64) { cancel() },
+		ProgressInterval: 1000,
+	}
+	_, n, err := whirlpool.SumReaderContext(ctx, r, opts)
+
+	var ie *whirlpool.InterruptedError
+	if !errors.As(err, &ie) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("SumReaderContext: err = %v want an InterruptedError for %v", err, context.Canceled)
+	}
+	if read := int64(len(data) - r.Len()); ie.N != 1000 || n != 1000 || read != 1000 {
+		t.Fatalf("SumReaderContext hashed %d bytes, reported %d, left the reader at %d; want 1000", ie.N, n, read)
+	}
+
+	sum, m, err := whirlpool.SumReaderContext(context.Background(), r, &whirlpool.SumOptions{State: ie.State})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n+m != int64(len(data)) {
+		t.Fatalf("SumReaderContext read %d + %d bytes want %d", n, m, len(data))
+	}
+	if sum != want {
+		t.Fatalf("SumReaderContext(resumed) = %X want %X", sum, want)
+	}
+}
+
+// cancelReader cancels a context once it has returned more than limit bytes.
+type cancelReader struct {
+	r      io.Reader
+	n      int64
+	limit  int64
+	cancel context.CancelFunc
+}
+
+func (c *cancelReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	if c.n += int64(n); c.n > c.limit {
+		c.cancel()
+	}
+	return n, err
+}
+
+func TestSumReaderContextResume(t *testing.T) {
+	data := make([]byte, 300000)
+	for i := range data {
+		data[i] = byte(i * 31)
+	}
+	want := whirlpool.Sum(data)
+
+	r := bytes.NewReader(data)
+	ctx, cancel := context.WithCancel(context.Background())
+	cr := &cancelReader{r: iotest.HalfReader(r), limit: 100001, cancel: cancel}
+	_, n, err := whirlpool.SumReaderContext(ctx, cr, nil)
+
+	var ie *whirlpool.InterruptedError
+	if !errors.As(err, &ie) || !errors.Is(err, context.Canceled) {
+		t.Fatalf("SumReaderContext: err = %v want an InterruptedError for %v", err, context.Canceled)
+	}
+	if ie.N != n || n != cr.n {
+		t.Fatalf("SumReaderContext hashed %d bytes, reported %d, read %d", ie.N, n, cr.n)
+	}
+
+	// Resume with the rest of the reader.
+	sum, m, err := whirlpool.SumReaderContext(context.Background(), r, &whirlpool.SumOptions{State: ie.State})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n+m != int64(len(data)) {
+		t.Fatalf("SumReaderContext read %d + %d bytes want %d", n, m, len(data))
+	}
+	if sum != want {
+		t.Fatalf("SumReaderContext(resumed) = %X want %X", sum, want)
+	}
+}
+
+// TestSumReaderContextResumeProgress interrupts a hash twice and checks that
+// the counts reported after each resume include the bytes hashed before.
+func TestSumReaderContextResumeProgress(t *testing.T) {
+	data := make([]byte, 300000)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	want := whirlpool.Sum(data)
+
+	r := bytes.NewReader(data)
+	var (
+		state []byte
+		total int64
+	)
+	for i := 0; i < 2; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		cr := &cancelReader{r: iotest.HalfReader(r), limit: 50001, cancel: cancel}
+		_, n, err := whirlpool.SumReaderContext(ctx, cr, &whirlpool.SumOptions{State: state})
+		var ie *whirlpool.InterruptedError
+		if !errors.As(err, &ie) {
+			t.Fatalf("SumReaderContext: err = %v want an InterruptedError", err)
+		}
+		if total += n; ie.N != total {
+			t.Fatalf("SumReaderContext(interrupt %d) hashed %d bytes want %d", i, ie.N, total)
+		}
+		state = ie.State
+	}
+
+	var got []int64
+	opts := &whirlpool.SumOptions{
+		Progress:         func(n int64) { got = append(got, n) },
+		ProgressInterval: 50000,
+		State:            state,
+	}
+	sum, _, err := whirlpool.SumReaderContext(context.Background(), iotest.OneByteReader(r), opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != want {
+		t.Fatalf("SumReaderContext(resumed) = %X want %X", sum, want)
+	}
+	var wantProgress []int64
+	for n := (total/50000 + 1) * 50000; n <= int64(len(data)); n += 50000 {
+		wantProgress = append(wantProgress, n)
+	}
+	if !reflect.DeepEqual(got, wantProgress) {
+		t.Fatalf("progress = %v want %v", got, wantProgress)
+	}
+}
+
+func TestSumReaderContextBadState(t *testing.T) {
+	opts := &whirlpool.SumOptions{State: []byte("nonsense")}
+	if _, _, err := whirlpool.SumReaderContext(context.Background(), strings.NewReader(""), opts); err == nil {
+		t.Fatal("SumReaderContext with a bad state did not fail")
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"hash"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// The reference implementation below follows the specification literally:
+// the state is an 8x8 matrix of bytes, the S-box is built from its
+// mini-boxes and every step of a round is computed on its own. It shares no
+// code or tables with the package.
+
+type refMatrix [8][8]byte
+
+// refSbox builds the S-box from the E, E^-1 and R mini-boxes.
+func refSbox() (S [256]byte) {
+	E := [16]byte{0x1, 0xb, 0x9, 0xc, 0xd, 0x6, 0xf, 0x3, 0xe, 0x8, 0x7, 0x4, 0xa, 0x2, 0x5, 0x0}
+	R := [16]byte{0x7, 0xc, 0xb, 0xd, 0xe, 0x4, 0x9, 0xf, 0x6, 0x3, 0x8, 0xa, 0x2, 0x5, 0x1, 0x0}
+	var Einv [16]byte
+	for i, e := range E {
+		Einv[e] = byte(i)
+	}
+	for u := range S {
+		a, b := E[u>>4], Einv[u&0xf]
+		r := R[a^b]
+		S[u] = E[a^r]<<4 | Einv[b^r]
+	}
+	return
+}
+
+var refS = refSbox()
+
+// refMul multiplies in GF(2^8) modulo x^8 + x^4 + x^3 + x^2 + 1.
+func refMul(a, b byte) byte {
+	var p uint16
+	for i := uint(0); i < 8; i++ {
+		if b>>i&1 != 0 {
+			p ^= uint16(a) << i
+		}
+	}
+	for i := uint(15); i >= 8; i-- {
+		if p>>i&1 != 0 {
+			p ^= 0x11d << (i - 8)
+		}
+	}
+	return byte(p)
+}
+
+// refRound computes ρ[k](a) = σ[k] θ π γ(a).
+func refRound(k, a refMatrix) refMatrix {
+	var g, p, t refMatrix
+	// γ: substitute every byte.
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			g[i][j] = refS[a[i][j]]
+		}
+	}
+	// π: rotate column j down by j positions.
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			p[i][j] = g[(i-j)&7][j]
+		}
+	}
+	// θ: multiply by the circulant matrix cir(1, 1, 4, 1, 8, 5, 2, 9).
+	c := [8]byte{1, 1, 4, 1, 8, 5, 2, 9}
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			for l := 0; l < 8; l++ {
+				t[i][j] ^= refMul(p[i][l], c[(j-l)&7])
+			}
+		}
+	}
+	// σ: add the key.
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			t[i][j] ^= k[i][j]
+		}
+	}
+	return t
+}
+
+// refW encrypts m with the W block cipher under the key k.
+func refW(k, m refMatrix) refMatrix {
+	var state refMatrix
+	for i := 0; i < 8; i++ {
+		for j := 0; j < 8; j++ {
+			state[i][j] = m[i][j] ^ k[i][j]
+		}
+	}
+	for r := 1; r <= 10; r++ {
+		var c refMatrix
+		for j := 0; j < 8; j++ {
+			c[0][j] = refS[8*(r-1)+j]
+		}
+		k = refRound(c, k)
+		state = refRound(k, state)
+	}
+	return state
+}
+
+// refPad pads the first nbits bits of msg with a 1-bit, then 0-bits up to an
+// odd multiple of 256 bits, then the length in 256 bits.
+func refPad(msg []byte, nbits uint64) []byte {
+	padded, n := appendBits(nil, 0, msg, nbits)
+	padded, n = appendBits(padded, n, []byte{0x80}, 1)
+	for n%512 != 256 {
+		padded, n = appendBits(padded, n, []byte{0}, 1)
+	}
+	var length [32]byte
+	for i, l := 31, nbits; l != 0; i, l = i-1, l>>8 {
+		length[i] = byte(l)
+	}
+	padded, _ = appendBits(padded, n, length[:], 256)
+	return padded
+}
+
+// refSum returns the whirlpool of the first nbits bits of msg.
+func refSum(msg []byte, nbits uint64) []byte {
+	padded := refPad(msg, nbits)
+	var h refMatrix
+	for len(padded) > 0 {
+		var m refMatrix
+		for i := 0; i < 64; i++ {
+			m[i/8][i%8] = padded[i]
+		}
+		w := refW(h, m)
+		for i := 0; i < 8; i++ {
+			for j := 0; j < 8; j++ {
+				h[i][j] ^= w[i][j] ^ m[i][j]
+			}
+		}
+		padded = padded[64:]
+	}
+
+	var digest []byte
+	for i := 0; i < 8; i++ {
+		digest = append(digest, h[i][:]...)
+	}
+	return digest
+}
+
+// appendBits appends the first n bits of src to the first nbits bits of
+// dst, most significant bit first, and returns the result and its length.
+func appendBits(dst []byte, nbits uint64, src []byte, n uint64) ([]byte, uint64) {
+	for i := uint64(0); i < n; i++ {
+		if nbits%8 == 0 {
+			dst = append(dst, 0)
+		}
+		if src[i/8]&(0x80>>(i%8)) != 0 {
+			dst[nbits/8] |= 0x80 >> (nbits % 8)
+		}
+		nbits++
+	}
+	return dst, nbits
+}
+
+func TestReference(t *testing.T) {
+	for _, g := range golden {
+		if s, w := refSum([]byte(g.in), uint64(len(g.in))*8), whirlpool.Sum([]byte(g.in)); !bytes.Equal(s, w[:]) {
+			t.Fatalf("reference(%s) = %X want %X", g.in, s, w)
+		}
+	}
+}
+
+// FuzzReference compares New against the reference implementation. The
+// message is written in two pieces split at split, and its last trim bits
+// are dropped.
+func FuzzReference(f *testing.F) {
+	f.Add([]byte(""), uint16(0), uint8(0))
+	f.Add([]byte("abc"), uint16(1), uint8(3))
+	f.Add(bytes.Repeat([]byte{0xa5}, 31), uint16(0), uint8(1))
+	f.Add(bytes.Repeat([]byte{0xff}, 32), uint16(32), uint8(0))
+	f.Add(bytes.Repeat([]byte("whirlpool"), 15), uint16(64), uint8(7))
+	f.Add(bytes.Repeat([]byte{0x5a}, 200), uint16(63), uint8(5))
+	f.Fuzz(func(t *testing.T, data []byte, split uint16, trim uint8) {
+		nbits := uint64(len(data)) * 8
+		if len(data) > 0 {
+			nbits -= uint64(trim % 8)
+		}
+		s := uint64(split) * 8
+		if s > nbits {
+			s = nbits &^ 7
+		}
+
+		h := whirlpool.New().(whirlpool.BitHash)
+		h.Write(data[:s/8])
+		h.WriteBits(data[s/8:], nbits-s)
+		if got, want := h.Sum(nil), refSum(data, nbits); !bytes.Equal(got, want) {
+			t.Fatalf("whirlpool(%x, %d bits, split at %d) = %X want %X", data, nbits, s, got, want)
+		}
+	})
+}
+
+// FuzzOps runs a program of Write, WriteBits, WriteString, ReadFrom, Sum,
+// Reset and Clone operations and checks every Sum against the reference
+// implementation of the bits written so far. Each operation takes two bytes
+// of ops: its kind and an argument.
+func FuzzOps(f *testing.F) {
+	f.Add([]byte{0, 10, 2, 0, 0, 70, 2, 0}, []byte("The quick brown fox jumps over the lazy dog"))
+	f.Add([]byte{1, 3, 0, 64, 1, 13, 2, 0, 4, 0, 0, 5, 2, 0, 5, 0, 2, 0}, bytes.Repeat([]byte{0x33}, 100))
+	f.Add([]byte{0, 30, 4, 0, 1, 7, 5, 0, 0, 40, 2, 0, 3, 0, 0, 1, 2, 0}, bytes.Repeat([]byte("0123456789"), 20))
+	f.Add([]byte{6, 20, 7, 150, 2, 0, 1, 5, 7, 70, 6, 64, 2, 0}, bytes.Repeat([]byte("abcdefg"), 60))
+	f.Fuzz(func(t *testing.T, ops []byte, data []byte) {
+		type hasher struct {
+			h     hash.Hash
+			msg   []byte // The bits written so far.
+			nbits uint64
+		}
+		cur, other := &hasher{h: whirlpool.New()}, (*hasher)(nil)
+
+		for ; len(ops) >= 2; ops = ops[2:] {
+			arg := int(ops[1])
+			switch ops[0] % 8 {
+			case 0: // Write arg bytes.
+				if arg > len(data) {
+					arg = len(data)
+				}
+				cur.h.Write(data[:arg])
+				cur.msg, cur.nbits = appendBits(cur.msg, cur.nbits, data, uint64(arg)*8)
+				data = data[arg:]
+			case 1: // WriteBits arg bits.
+				n := uint64(arg)
+				if n > uint64(len(data))*8 {
+					n = uint64(len(data)) * 8
+				}
+				cur.h.(whirlpool.BitHash).WriteBits(data, n)
+				cur.msg, cur.nbits = appendBits(cur.msg, cur.nbits, data, n)
+				data = data[(n+7)/8:]
+			case 2: // Sum.
+				if got, want := cur.h.Sum(nil), refSum(cur.msg, cur.nbits); !bytes.Equal(got, want) {
+					t.Fatalf("whirlpool(%x, %d bits) = %X want %X", cur.msg, cur.nbits, got, want)
+				}
+			case 3: // Reset.
+				cur.h.Reset()
+				cur.msg, cur.nbits = nil, 0
+			case 4: // Clone, and continue with the clone.
+				c, err := cur.h.(hash.Cloner).Clone()
+				if err != nil {
+					t.Fatal(err)
+				}
+				clone := &hasher{h: c, msg: append([]byte(nil), cur.msg...), nbits: cur.nbits}
+				cur, other = clone, cur
+			case 5: // Switch back to the hash the last clone came from.
+				if other != nil {
+					cur, other = other, cur
+				}
+			case 6: // WriteString arg bytes.
+				if arg > len(data) {
+					arg = len(data)
+				}
+				cur.h.(io.StringWriter).WriteString(string(data[:arg]))
+				cur.msg, cur.nbits = appendBits(cur.msg, cur.nbits, data, uint64(arg)*8)
+				data = data[arg:]
+			case 7: // ReadFrom arg bytes, in reads of varying sizes.
+				if arg > len(data) {
+					arg = len(data)
+				}
+				cur.h.(io.ReaderFrom).ReadFrom(iotest.HalfReader(bytes.NewReader(data[:arg])))
+				cur.msg, cur.nbits = appendBits(cur.msg, cur.nbits, data, uint64(arg)*8)
+				data = data[arg:]
+			}
+		}
+
+		for _, x := range []*hasher{cur, other} {
+			if x == nil {
+				continue
+			}
+			if got, want := x.h.Sum(nil), refSum(x.msg, x.nbits); !bytes.Equal(got, want) {
+				t.Fatalf("whirlpool(%x, %d bits) = %X want %X", x.msg, x.nbits, got, want)
+			}
+		}
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ignore
+
+// This program generates transform.go, the generic compression function
+// with every round unrolled to constant indices. Invoke it as
+//
+//	go run gen_transform.go -out transform.go
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+var out = flag.String("out", "transform.go", "output file")
+
+func main() {
+	flag.Parse()
+
+	var b bytes.Buffer
+	p := func(format string, args ...interface{}) {
+		fmt.Fprintf(&b, format, args...)
+		b.WriteByte('\n')
+	}
+
+	p("// Code generated by gen_transform.go. DO NOT EDIT.")
+	p("")
+	p("package whirlpool")
+	p("")
+	p(`import "encoding/binary"`)
+	p("")
+	transform(p, "transformGeneric", "compresses a block into the hash state.", false)
+	p("")
+	transform(p, "transformTraced", "is transformGeneric passing every intermediate value to w.trace.", true)
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// transform emits the compression function name, which reports its
+// intermediate values to w.trace if traced is set.
+func transform(p func(string, ...interface{}), name, doc string, traced bool) {
+	p("// %s %s", name, doc)
+	p("func (w *whirlpool) %s(buf *[wblockBytes]byte) {", name)
+	p("// Lookup tables and round constants.")
+	p("C0, C1, C2, C3 := w.v.c[0], w.v.c[1], w.v.c[2], w.v.c[3]")
+	p("C4, C5, C6, C7 := w.v.c[4], w.v.c[5], w.v.c[6], w.v.c[7]")
+	p("rc := w.v.rc")
+	if traced {
+		p("trace := w.trace")
+	}
+	p("")
+
+	p("// Map the buffer to a block.")
+	for i := 0; i < 8; i++ {
+		p("b%d := binary.BigEndian.Uint64(buf[%d:])", i, 8*i)
+	}
+	if traced {
+		p("trace(TraceEvent{Kind: TraceInput, Value: %s})", words("b"))
+	}
+	p("")
+
+	p("// Compute & apply K^0 to the cipher state.")
+	p("k0, k1, k2, k3, k4, k5, k6, k7 := w.hash[0], w.hash[1], w.hash[2], w.hash[3], w.hash[4], w.hash[5], w.hash[6], w.hash[7]")
+	for i := 0; i < 8; i++ {
+		p("s%d := b%d ^ k%d", i, i, i)
+	}
+	if traced {
+		p("trace(TraceEvent{Kind: TraceRoundKey, Value: %s})", words("k"))
+		p("trace(TraceEvent{Kind: TraceCipherState, Value: %s})", words("s"))
+	}
+	p("")
+
+	p("// Iterate over all the rounds.")
+	p("for r := 1; r <= rounds; r++ {")
+	p("// Compute K^r from K^(r-1).")
+	round(p, "k", "")
+	p("l0 ^= rc[r]")
+	p("k0, k1, k2, k3, k4, k5, k6, k7 = l0, l1, l2, l3, l4, l5, l6, l7")
+	p("")
+	p("// Apply r-th round transformation.")
+	round(p, "s", "k")
+	p("s0, s1, s2, s3, s4, s5, s6, s7 = l0, l1, l2, l3, l4, l5, l6, l7")
+	p("")
+	if traced {
+		p("trace(TraceEvent{Kind: TraceRoundKey, Round: r, Value: %s})", words("k"))
+		p("trace(TraceEvent{Kind: TraceCipherState, Round: r, Value: %s})", words("s"))
+	}
+	p("}")
+	p("")
+
+	p("// Apply the Miyaguchi-Preneel compression function.")
+	for i := 0; i < 8; i++ {
+		p("w.hash[%d] ^= s%d ^ b%d", i, i, i)
+	}
+	if traced {
+		p("trace(TraceEvent{Kind: TraceHashState, Value: w.hash})")
+	}
+	p("}")
+}
+
+// round emits l0 to l7 = θπγ(x0..x7), XORed with key0..key7 if key is set.
+// Word i takes byte t from word (i-t) mod 8, so every index is constant.
+func round(p func(string, ...interface{}), x, key string) {
+	for i := 0; i < 8; i++ {
+		var terms []string
+		for t := 0; t < 8; t++ {
+			shift := ""
+			if t < 7 {
+				shift = fmt.Sprintf(">>%d", 56-8*t)
+			}
+			terms = append(terms, fmt.Sprintf("C%d[byte(%s%d%s)]", t, x, (i-t)&7, shift))
+		}
+		if key != "" {
+			terms = append(terms, fmt.Sprintf("%s%d", key, i))
+		}
+		// The key schedule comes first in each round and declares l0 to l7.
+		op := ":="
+		if key != "" {
+			op = "="
+		}
+		p("l%d %s %s", i, op, strings.Join(terms, " ^\n"))
+	}
+}
+
+// words returns a [8]uint64 literal of the variables x0 to x7.
+func words(x string) string {
+	var vars []string
+	for i := 0; i < 8; i++ {
+		vars = append(vars, fmt.Sprintf("%s%d", x, i))
+	}
+	return "[8]uint64{" + strings.Join(vars, ", ") + "}"
+}
@@ -0,0 +1,106 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hkdf implements the HMAC-based Extract-and-Expand Key Derivation
+// Function of RFC 5869 instantiated with whirlpool.
+//
+// Every function takes the hash constructor h, which is whirlpool.New, or
+// whirlpool.NewConstantTime when the secret must not leak through cache
+// timing.
+package hkdf
+
+import (
+	"crypto/hmac"
+	"errors"
+	"hash"
+	"io"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// MaxLength is the largest number of bytes that can be expanded from a
+// single pseudorandom key.
+const MaxLength = 255 * whirlpool.Size
+
+// ErrOutputTooLong is returned when more than MaxLength bytes of output
+// keying material are requested.
+var ErrOutputTooLong = errors.New("hkdf: output longer than 255 * whirlpool.Size bytes")
+
+// Extract generates a pseudorandom key from secret and salt for use with
+// Expand, hashing with h. A nil salt is the same as a salt of whirlpool.Size
+// zero bytes.
+func Extract(h func() hash.Hash, secret, salt []byte) []byte {
+	extractor := hmac.New(h, salt)
+	extractor.Write(secret)
+	return extractor.Sum(nil)
+}
+
+// Expand derives length bytes of output keying material from
+// pseudorandomKey, which should come from Extract, and the optional context
+// info, hashing with h.
+func Expand(h func() hash.Hash, pseudorandomKey, info []byte, length int) ([]byte, error) {
+	if length > MaxLength {
+		return nil, ErrOutputTooLong
+	}
+	out := make([]byte, length)
+	if _, err := io.ReadFull(NewExpander(h, pseudorandomKey, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// New returns a Reader from which keys can be read, using Extract on secret
+// and salt and then expanding the result with info. Reading more than
+// MaxLength bytes in total fails with ErrOutputTooLong.
+func New(h func() hash.Hash, secret, salt, info []byte) io.Reader {
+	return NewExpander(h, Extract(h, secret, salt), info)
+}
+
+// NewExpander returns a Reader of the output keying material expanded from
+// pseudorandomKey and info. Reading more than MaxLength bytes in total fails
+// with ErrOutputTooLong.
+func NewExpander(h func() hash.Hash, pseudorandomKey, info []byte) io.Reader {
+	return &expander{
+		expander: hmac.New(h, pseudorandomKey),
+		info:     info,
+		counter:  1,
+	}
+}
+
+// expander generates the blocks T(1), T(2), ... of RFC 5869 on demand.
+type expander struct {
+	expander hash.Hash // HMAC keyed with the pseudorandom key.
+	info     []byte    // Context and application specific information.
+	counter  byte      // Index of the next block; wraps to 0 when exhausted.
+	prev     []byte    // The last generated block.
+	buf      []byte    // Unread bytes of prev.
+}
+
+func (e *expander) Read(p []byte) (int, error) {
+	// The counter is a byte, so once block 255 is generated it wraps to 0
+	// and no blocks remain.
+	need := len(p)
+	remains := len(e.buf) + int(255-e.counter+1)*whirlpool.Size
+	if remains < need {
+		return 0, ErrOutputTooLong
+	}
+
+	n := copy(p, e.buf)
+	p = p[n:]
+	for len(p) > 0 {
+		// T(i) = HMAC(PRK, T(i-1) || info || i).
+		e.expander.Reset()
+		e.expander.Write(e.prev)
+		e.expander.Write(e.info)
+		e.expander.Write([]byte{e.counter})
+		e.prev = e.expander.Sum(e.prev[:0])
+		e.counter++
+
+		e.buf = e.prev
+		n = copy(p, e.buf)
+		p = p[n:]
+	}
+	e.buf = e.buf[n:]
+	return need, nil
+}
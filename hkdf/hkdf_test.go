@@ -0,0 +1,129 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hkdf_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+	"github.com/jzelinskie/whirlpool/hkdf"
+)
+
+func seq(from, to int) []byte {
+	b := make([]byte, 0, to-from)
+	for i := from; i < to; i++ {
+		b = append(b, byte(i))
+	}
+	return b
+}
+
+type hkdfTest struct {
+	secret []byte
+	salt   []byte
+	info   []byte
+	prk    string
+	okm    string
+}
+
+// golden uses the inputs of the RFC 5869 test cases. The expected values
+// were checked against the OpenSSL implementation of HKDF.
+var golden = []hkdfTest{
+	{
+		bytes.Repeat([]byte{0x0b}, 22),
+		seq(0x00, 0x0d),
+		seq(0xf0, 0xfa),
+		"165B2E2A450052D60B2D8C26A8B9B3FB140575EA189DB969B6599723EAF7AFFDA865364ACAC2D85D57171EE40E8A94818B2AE8957DD495256525F4A71FFDC4C9",
+		"0D29F74CCD8640F44B0DD9638111C1B5766EFED752AF358109E2E7C9CD4A28EF2F90B2AD461FBA0744D4",
+	},
+	{
+		seq(0x00, 0x50),
+		seq(0x60, 0xb0),
+		seq(0xb0, 0x100),
+		"3770889708B73510585327A44FBA00522AE80BE28F6755864A17E69AD820561762985C1B3C1B07781897A680DC6DCC0EBBE2A899EFF98706C77DF5400817011B",
+		"4EBE4FE2DCCEC42661699500BE279A993FED90351E19373B3926FAA3A410700B2BBF77E254CF1451AE6068D64A0904D966F4FF25498445A501B88F50D21E3A68A890E09445DC5886DD00E7F4F7C58A512170",
+	},
+	{
+		bytes.Repeat([]byte{0x0b}, 22),
+		nil,
+		nil,
+		"9FC5037DD5DE8CD1984F0142D3A222ED07C362D5126E001F5ABFF2FCEBC50C5199BE55EE17FC146C4B066B43F5FCCF7B4A09761350DB399415256AEA8477F795",
+		"110632D0F7AEFAC31771FC66C22BB3462614B81E4B04BA7F2B662E0BD694F56458615F9A9CB56C57ECF2",
+	},
+}
+
+// implementations lists the constructors the functions are tested with.
+var implementations = []struct {
+	name string
+	new  func() hash.Hash
+}{
+	{"New", whirlpool.New},
+	{"NewConstantTime", whirlpool.NewConstantTime},
+}
+
+func TestGolden(t *testing.T) {
+	for _, impl := range implementations {
+		for i, g := range golden {
+			prk := hkdf.Extract(impl.new, g.secret, g.salt)
+			if s := fmt.Sprintf("%X", prk); s != g.prk {
+				t.Fatalf("Extract-%s[%d] = %s want %s", impl.name, i, s, g.prk)
+			}
+
+			okm, err := hkdf.Expand(impl.new, prk, g.info, len(g.okm)/2)
+			if err != nil {
+				t.Fatalf("Expand-%s[%d] returned %v", impl.name, i, err)
+			}
+			if s := fmt.Sprintf("%X", okm); s != g.okm {
+				t.Fatalf("Expand-%s[%d] = %s want %s", impl.name, i, s, g.okm)
+			}
+
+			// Reading in small pieces must give the same stream.
+			r := hkdf.New(impl.new, g.secret, g.salt, g.info)
+			var out []byte
+			buf := make([]byte, 7)
+			for len(out) < len(okm) {
+				n, err := r.Read(buf)
+				if err != nil {
+					t.Fatalf("Read-%s[%d] returned %v", impl.name, i, err)
+				}
+				out = append(out, buf[:n]...)
+			}
+			if s := fmt.Sprintf("%X", out[:len(okm)]); s != g.okm {
+				t.Fatalf("New-%s[%d] = %s want %s", impl.name, i, s, g.okm)
+			}
+		}
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	prk, _ := hex.DecodeString(golden[0].prk)
+
+	okm, err := hkdf.Expand(whirlpool.New, prk, nil, hkdf.MaxLength)
+	if err != nil {
+		t.Fatalf("Expand(MaxLength) returned %v", err)
+	}
+	if len(okm) != 255*whirlpool.Size {
+		t.Fatalf("Expand(MaxLength) returned %d bytes", len(okm))
+	}
+	if _, err := hkdf.Expand(whirlpool.New, prk, nil, hkdf.MaxLength+1); err != hkdf.ErrOutputTooLong {
+		t.Fatalf("Expand(MaxLength+1) = %v want %v", err, hkdf.ErrOutputTooLong)
+	}
+
+	// The reader fails once the limit would be exceeded.
+	r := hkdf.NewExpander(whirlpool.New, prk, nil)
+	if _, err := io.ReadFull(r, make([]byte, hkdf.MaxLength-1)); err != nil {
+		t.Fatalf("Read(MaxLength-1) returned %v", err)
+	}
+	if _, err := r.Read(make([]byte, 2)); err != hkdf.ErrOutputTooLong {
+		t.Fatalf("Read past MaxLength = %v want %v", err, hkdf.ErrOutputTooLong)
+	}
+	if n, err := r.Read(make([]byte, 1)); n != 1 || err != nil {
+		t.Fatalf("Read of last byte = %d, %v", n, err)
+	}
+}
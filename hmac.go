@@ -0,0 +1,24 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"crypto/hmac"
+	"hash"
+)
+
+// NewHMAC returns a new hash.Hash computing HMAC-Whirlpool with the given
+// key. Keys longer than BlockSize are hashed first, as in RFC 2104.
+func NewHMAC(key []byte) hash.Hash {
+	return hmac.New(New, key)
+}
+
+// VerifyMAC reports whether messageMAC is a valid HMAC-Whirlpool tag for
+// message under key. The tags are compared in constant time.
+func VerifyMAC(key, message, messageMAC []byte) bool {
+	mac := NewHMAC(key)
+	mac.Write(message)
+	return hmac.Equal(mac.Sum(nil), messageMAC)
+}
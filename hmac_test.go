@@ -0,0 +1,111 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+type hmacTest struct {
+	key []byte
+	in  []byte
+	out string
+}
+
+func seq(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+// goldenHMAC uses the inputs of the RFC 4231 test cases. The expected
+// values were checked against the OpenSSL implementation of HMAC-Whirlpool.
+var goldenHMAC = []hmacTest{
+	{
+		bytes.Repeat([]byte{0x0b}, 20),
+		[]byte("Hi There"),
+		"8a2c9b1ccf4b28660de78af9db15b7c94d129ec960ca9a950a665ea5e88362e24f4474354e18512d956d9bb7e6bbbb50b9ba0d3093b0a17c6ec2aa91e57169ce",
+	},
+	{
+		[]byte("Jefe"),
+		[]byte("what do ya want for nothing?"),
+		"3d595ccd1d4f4cfd045af53ba7d5c8283fee6ded6eaf1269071b6b4ea64800056b5077c6a942cfa1221bd4e5aed791276e5dd46a407d2b8007163d3e7cd1de66",
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 20),
+		bytes.Repeat([]byte{0xdd}, 50),
+		"ea252f252e230e3d1950cf44679e31d9de70d1dec6f41dbe38a12d76e2b54cffa2637f0408a48a0a387315ef1118055d373dc295bba3563276f846a0957fb823",
+	},
+	{
+		seq(26)[1:],
+		bytes.Repeat([]byte{0xcd}, 50),
+		"35bc33e2ed71e1cb01c140ddd3291ae3f84e9f0dce18005a1123df199983a211fe744b244449a1c093b17584069359bc6a95352271d78e2ef7a6f21dc28ab3c1",
+	},
+	{
+		bytes.Repeat([]byte{0x0c}, 20),
+		[]byte("Test With Truncation"),
+		"0b65a88ca3f6709def0758b525729ee92413d372e07d1e4a65e16adbab5793c35431061c56f6b8eb269c90f8d39ee8ac4d2e68091d4f3d4631cdf04c1c42d480",
+	},
+	// A key of exactly BlockSize bytes is used as is.
+	{
+		seq(whirlpool.BlockSize),
+		[]byte("Key exactly one block long"),
+		"068d1e785e12d09549e4af32193cc57f5bf3dfd30129d20314cbc964f0778bfca00551fb898c9cc71c8126ff37417532538c811b9ab7b6f264b17aeb82d07ba3",
+	},
+	// Keys longer than BlockSize are hashed first.
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("Test Using Larger Than Block-Size Key - Hash Key First"),
+		"bf0c49ca78d52e92357e0ff1c2978f8820c9b4bcbbf5118179ca40385d51bd78956d5a3ba7010effebcbaf5c431f1757742982bdeb69e6bfb415151ab2c2b43f",
+	},
+	{
+		bytes.Repeat([]byte{0xaa}, 131),
+		[]byte("This is a test using a larger than block-size key and a larger than block-size data. The key needs to be hashed before being used by the HMAC algorithm."),
+		"1dec7ddb9e826b04c5c033a7e156415e830eb8fca4958c83ba1a1c1cac0c4f1c8a6bacf41b18a380f59b6832e4ccb571b7fd27e6e2688bcaf180e4adca24c228",
+	},
+}
+
+func TestGoldenHMAC(t *testing.T) {
+	for _, impl := range implementations {
+		for i, g := range goldenHMAC {
+			h := hmac.New(impl.new, g.key)
+			h.Write(g.in)
+			if s := fmt.Sprintf("%x", h.Sum(nil)); s != g.out {
+				t.Fatalf("HMAC-%s[%d](%q) = %s want %s", impl.name, i, g.in, s, g.out)
+			}
+		}
+	}
+}
+
+// TestNewHMAC checks the NewHMAC and VerifyMAC shorthands for hmac.New(New).
+func TestNewHMAC(t *testing.T) {
+	for i, g := range goldenHMAC {
+		h := whirlpool.NewHMAC(g.key)
+		h.Write(g.in)
+		if s := fmt.Sprintf("%x", h.Sum(nil)); s != g.out {
+			t.Fatalf("NewHMAC[%d](%q) = %s want %s", i, g.in, s, g.out)
+		}
+
+		mac, _ := hex.DecodeString(g.out)
+		if !whirlpool.VerifyMAC(g.key, g.in, mac) {
+			t.Fatalf("VerifyMAC[%d] rejected a valid tag", i)
+		}
+		mac[len(mac)-1] ^= 1
+		if whirlpool.VerifyMAC(g.key, g.in, mac) {
+			t.Fatalf("VerifyMAC[%d] accepted a modified tag", i)
+		}
+		if whirlpool.VerifyMAC(g.key, g.in, mac[:32]) {
+			t.Fatalf("VerifyMAC[%d] accepted a truncated tag", i)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"io"
+	"os"
+	"sync"
+)
+
+// WriteString adds s to the running hash without converting it to a byte
+// slice.
+func (w *whirlpool) WriteString(s string) (int, error) {
+	n := len(s)
+	if w.bufferBits&7 != 0 {
+		// Every byte has to be shifted; pass them through a small chunk.
+		var chunk [wblockBytes]byte
+		for len(s) > 0 {
+			c := copy(chunk[:], s)
+			w.write(chunk[:c], uint64(c)*8)
+			s = s[c:]
+		}
+		return n, nil
+	}
+	w.addLength(uint64(n) * 8)
+
+	for len(s) > 0 {
+		c := copy(w.buffer[w.bufferPos:], s)
+		w.bufferPos += c
+		s = s[c:]
+		if w.bufferPos == wblockBytes {
+			w.transform()
+			w.bufferPos = 0
+		}
+	}
+	w.bufferBits = 8 * w.bufferPos
+	w.buffer[w.bufferPos] = 0
+	return n, nil
+}
+
+// scratchSize is the size of the reads of ReadFrom, a whole number of blocks.
+const scratchSize = 512 * wblockBytes
+
+// scratchPool holds the scratch space of ReadFrom.
+var scratchPool = sync.Pool{
+	New: func() interface{} { return new([scratchSize]byte) },
+}
+
+// ReadFrom adds the data read from r until EOF to the running hash. The data
+// is read into block-aligned scratch space whose whole blocks are compressed
+// in place.
+func (w *whirlpool) ReadFrom(r io.Reader) (int64, error) {
+	scratch := scratchPool.Get().(*[scratchSize]byte)
+	defer scratchPool.Put(scratch)
+
+	var total int64
+	for {
+		if w.bufferBits&7 != 0 {
+			// The buffer ends mid-byte, so every byte has to be shifted.
+			n, err := r.Read(scratch[:])
+			total += int64(n)
+			w.write(scratch[:n], uint64(n)*8)
+			if err != nil {
+				return total, eofIsNil(err)
+			}
+			continue
+		}
+
+		// Start the scratch space with the partial block on the buffer, so
+		// that it is followed by the data and every block is whole.
+		pos := copy(scratch[:], w.buffer[:w.bufferPos])
+		n, err := r.Read(scratch[pos:])
+		total += int64(n)
+		w.addLength(uint64(n) * 8)
+
+		end := pos + n
+		blocks := end &^ (wblockBytes - 1)
+		w.transformBlocks(scratch[:blocks])
+		w.bufferPos = copy(w.buffer[:], scratch[blocks:end])
+		w.bufferBits = 8 * w.bufferPos
+		w.buffer[w.bufferPos] = 0
+		if err != nil {
+			return total, eofIsNil(err)
+		}
+	}
+}
+
+// eofIsNil returns err, or nil for io.EOF.
+func eofIsNil(err error) error {
+	if err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// SumReader returns the whirlpool checksum of the data read from r until EOF
+// and the number of bytes read. If reading fails, it returns the number of
+// bytes read before the error and the error.
+func SumReader(r io.Reader) ([Size]byte, int64, error) {
+	w := &whirlpool{v: &variantFinal}
+	n, err := io.Copy(w, r)
+	if err != nil {
+		return [Size]byte{}, n, err
+	}
+	return w.checkSum(), n, nil
+}
+
+// SumFile returns the whirlpool checksum of the named file.
+func SumFile(name string) ([Size]byte, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return [Size]byte{}, err
+	}
+	defer f.Close()
+	sum, _, err := SumReader(f)
+	return sum, err
+}
+
+// Reader is an io.Reader that hashes the data read through it.
+type Reader struct {
+	r io.Reader
+	h whirlpool
+}
+
+// NewReader returns a Reader that reads from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: r, h: whirlpool{v: &variantFinal}}
+}
+
+// Read reads from the underlying reader and hashes the bytes it returned.
+func (r *Reader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.h.Write(p[:n])
+	return n, err
+}
+
+// Sum returns the whirlpool checksum of the data read so far, which is the
+// checksum of the whole stream once Read has returned io.EOF.
+func (r *Reader) Sum() [Size]byte {
+	h := r.h
+	return h.checkSum()
+}
+
+// Writer is an io.Writer that hashes the data written through it.
+type Writer struct {
+	w io.Writer
+	h whirlpool
+}
+
+// NewWriter returns a Writer that writes to w.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w, h: whirlpool{v: &variantFinal}}
+}
+
+// Write writes p to the underlying writer and hashes the bytes it accepted.
+func (w *Writer) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.h.Write(p[:n])
+	return n, err
+}
+
+// Sum returns the whirlpool checksum of the data written so far.
+func (w *Writer) Sum() [Size]byte {
+	h := w.h
+	return h.checkSum()
+}
@@ -0,0 +1,178 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+func TestSumReader(t *testing.T) {
+	for _, g := range golden {
+		sum, n, err := whirlpool.SumReader(iotest.HalfReader(strings.NewReader(g.in)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != int64(len(g.in)) {
+			t.Fatalf("SumReader(%s) read %d bytes want %d", g.in, n, len(g.in))
+		}
+		if s := fmt.Sprintf("%X", sum); s != g.out {
+			t.Fatalf("SumReader(%s) = %s want %s", g.in, s, g.out)
+		}
+	}
+
+	want := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("abc"), iotest.ErrReader(want))
+	if _, n, err := whirlpool.SumReader(r); err != want || n != 3 {
+		t.Fatalf("SumReader of a failing reader = %d, %v want 3, %v", n, err, want)
+	}
+}
+
+func TestSumFile(t *testing.T) {
+	// The file spans several 32 KiB reads and ends 37 bytes into a block.
+	data := make([]byte, 65<<10+37)
+	for i := range data {
+		data[i] = byte(i * 31)
+	}
+	name := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(name, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sum, err := whirlpool.SumFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := whirlpool.Sum(data); sum != want {
+		t.Fatalf("SumFile(%d bytes) = %X want %X", len(data), sum, want)
+	}
+
+	if _, err := whirlpool.SumFile(name + ".missing"); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("SumFile of a missing file: err = %v want %v", err, os.ErrNotExist)
+	}
+}
+
+func TestReader(t *testing.T) {
+	for _, g := range golden {
+		r := whirlpool.NewReader(iotest.OneByteReader(strings.NewReader(g.in)))
+		data, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != g.in {
+			t.Fatalf("Reader read %q want %q", data, g.in)
+		}
+		if s := fmt.Sprintf("%X", r.Sum()); s != g.out {
+			t.Fatalf("Reader(%s).Sum() = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+// shortWriter accepts at most limit bytes in total.
+type shortWriter struct {
+	bytes.Buffer
+	limit int
+}
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit-w.Len() {
+		n, _ := w.Buffer.Write(p[:w.limit-w.Len()])
+		return n, io.ErrShortWrite
+	}
+	return w.Buffer.Write(p)
+}
+
+func TestWriter(t *testing.T) {
+	for _, g := range golden {
+		var buf bytes.Buffer
+		w := whirlpool.NewWriter(&buf)
+		io.WriteString(w, g.in[:len(g.in)/2])
+		w.Sum()
+		io.WriteString(w, g.in[len(g.in)/2:])
+		if buf.String() != g.in {
+			t.Fatalf("Writer wrote %q want %q", buf.String(), g.in)
+		}
+		if s := fmt.Sprintf("%X", w.Sum()); s != g.out {
+			t.Fatalf("Writer(%s).Sum() = %s want %s", g.in, s, g.out)
+		}
+	}
+
+	// Only the bytes the underlying writer accepted are hashed.
+	sw := &shortWriter{limit: 3}
+	w := whirlpool.NewWriter(sw)
+	if _, err := io.WriteString(w, "abcdef"); err != io.ErrShortWrite {
+		t.Fatalf("Writer: err = %v want %v", err, io.ErrShortWrite)
+	}
+	if got, want := w.Sum(), whirlpool.Sum([]byte("abc")); got != want {
+		t.Fatalf("Writer(short).Sum() = %X want %X", got, want)
+	}
+}
+
+// TestWriteStringReadFrom checks WriteString and ReadFrom on a long message,
+// both byte-aligned and after a few bits that force every byte to be shifted.
+func TestWriteStringReadFrom(t *testing.T) {
+	msg := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 30)
+	for _, prefix := range []uint64{0, 3} {
+		ref := whirlpool.New().(whirlpool.BitHash)
+		ref.WriteBits([]byte{0xa0}, prefix)
+		ref.Write([]byte(msg))
+		want := fmt.Sprintf("%X", ref.Sum(nil))
+
+		h := whirlpool.New().(whirlpool.BitHash)
+		h.WriteBits([]byte{0xa0}, prefix)
+		h.(io.StringWriter).WriteString(msg[:100])
+		h.(io.StringWriter).WriteString(msg[100:])
+		if s := fmt.Sprintf("%X", h.Sum(nil)); s != want {
+			t.Fatalf("whirlpool(%d bits + WriteString) = %s want %s", prefix, s, want)
+		}
+
+		h.Reset()
+		h.WriteBits([]byte{0xa0}, prefix)
+		n, err := h.(io.ReaderFrom).ReadFrom(iotest.HalfReader(strings.NewReader(msg)))
+		if err != nil || n != int64(len(msg)) {
+			t.Fatalf("ReadFrom = %d, %v want %d, nil", n, err, len(msg))
+		}
+		if s := fmt.Sprintf("%X", h.Sum(nil)); s != want {
+			t.Fatalf("whirlpool(%d bits + ReadFrom) = %s want %s", prefix, s, want)
+		}
+	}
+
+	want := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("abc"), iotest.ErrReader(want))
+	if n, err := whirlpool.New().(io.ReaderFrom).ReadFrom(r); err != want || n != 3 {
+		t.Fatalf("ReadFrom of a failing reader = %d, %v want 3, %v", n, err, want)
+	}
+}
+
+func TestWriteStringAllocs(t *testing.T) {
+	msg := strings.Repeat("a", 1000)
+	h := whirlpool.New()
+	if n := testing.AllocsPerRun(100, func() { io.WriteString(h, msg) }); n > 0 {
+		t.Errorf("WriteString allocated %v times, want 0", n)
+	}
+}
+
+func TestReadFromAllocs(t *testing.T) {
+	data := make([]byte, 100<<10)
+	br := bytes.NewReader(data)
+	var src io.Reader = struct{ io.Reader }{br} // Hides WriteTo from io.Copy.
+	h := whirlpool.New()
+
+	// io.Copy uses ReadFrom, which reuses its scratch space.
+	if n := testing.AllocsPerRun(20, func() {
+		br.Reset(data)
+		io.Copy(h, src)
+	}); n >= 1 {
+		t.Errorf("io.Copy into the hash allocated %v times, want 0", n)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	magic         = "whirl\x01" // Identifier and format version.
+	magic0        = "whrl0\x01"
+	magicT        = "whrlT\x01"
+	magic256      = "wh256\x01"
+	magic384      = "wh384\x01"
+	marshaledSize = len(magic) + digestBytes + wblockBytes + 8 + lengthBytes
+)
+
+var (
+	// ErrInvalidStateIdentifier is returned by UnmarshalBinary when the
+	// state was not produced by the same version of whirlpool or uses an
+	// unknown format.
+	ErrInvalidStateIdentifier = errors.New("whirlpool: invalid hash state identifier")
+
+	// ErrInvalidStateSize is returned by UnmarshalBinary when the state is
+	// truncated or has trailing data.
+	ErrInvalidStateSize = errors.New("whirlpool: invalid hash state size")
+
+	// ErrCorruptState is returned by UnmarshalBinary when the fields of the
+	// state are inconsistent with each other.
+	ErrCorruptState = errors.New("whirlpool: corrupt hash state")
+)
+
+func (w *whirlpool) MarshalBinary() ([]byte, error) {
+	return w.AppendBinary(make([]byte, 0, marshaledSize))
+}
+
+func (w *whirlpool) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, w.v.magic...)
+	for i := 0; i < len(w.hash); i++ {
+		b = binary.BigEndian.AppendUint64(b, w.hash[i])
+	}
+
+	// Past the occupied bits the buffer may hold stale data from an earlier
+	// block, so only the occupied bytes are kept.
+	n := (w.bufferBits + 7) / 8
+	b = append(b, w.buffer[:n]...)
+	b = append(b, make([]byte, wblockBytes-n)...)
+
+	b = binary.BigEndian.AppendUint64(b, uint64(w.bufferBits))
+	b = append(b, w.bitLength[:]...)
+	return b, nil
+}
+
+func (w *whirlpool) UnmarshalBinary(b []byte) error {
+	if len(b) < len(magic) || string(b[:len(magic)]) != w.v.magic {
+		return ErrInvalidStateIdentifier
+	}
+	if len(b) != marshaledSize {
+		return ErrInvalidStateSize
+	}
+	b = b[len(magic):]
+
+	s := whirlpool{v: w.v, trace: w.trace}
+	for i := 0; i < len(s.hash); i++ {
+		s.hash[i] = binary.BigEndian.Uint64(b)
+		b = b[8:]
+	}
+	b = b[copy(s.buffer[:], b):]
+	bufferBits := binary.BigEndian.Uint64(b)
+	b = b[8:]
+	copy(s.bitLength[:], b)
+
+	// The buffer holds exactly the bits of the last, incomplete block.
+	if bufferBits >= wblockBits {
+		return ErrCorruptState
+	}
+	tally := uint64(s.bitLength[lengthBytes-2])<<8 | uint64(s.bitLength[lengthBytes-1])
+	if tally%wblockBits != bufferBits {
+		return ErrCorruptState
+	}
+
+	// Every bit after the occupied ones must be clear.
+	s.bufferBits = int(bufferBits)
+	s.bufferPos = s.bufferBits / 8
+	if s.buffer[s.bufferPos]&(0xff>>uint(s.bufferBits&7)) != 0 {
+		return ErrCorruptState
+	}
+	for i := s.bufferPos + 1; i < wblockBytes; i++ {
+		if s.buffer[i] != 0 {
+			return ErrCorruptState
+		}
+	}
+
+	*w = s
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+func TestGoldenMarshal(t *testing.T) {
+	for _, g := range golden {
+		h := whirlpool.New()
+		h2 := whirlpool.New()
+
+		io.WriteString(h, g.in[:len(g.in)/2])
+
+		state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatalf("could not marshal: %v", err)
+		}
+		stateAppend, err := h.(encoding.BinaryAppender).AppendBinary(make([]byte, 4, 32))
+		if err != nil {
+			t.Fatalf("could not append: %v", err)
+		}
+		if !bytes.Equal(state, stateAppend[4:]) {
+			t.Fatalf("AppendBinary = %x want %x", stateAppend[4:], state)
+		}
+
+		if err := h2.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+			t.Fatalf("could not unmarshal: %v", err)
+		}
+
+		io.WriteString(h, g.in[len(g.in)/2:])
+		io.WriteString(h2, g.in[len(g.in)/2:])
+
+		if s := fmt.Sprintf("%X", h.Sum(nil)); s != g.out {
+			t.Fatalf("whirlpool(%q) = %s want %s", g.in, s, g.out)
+		}
+		if s := fmt.Sprintf("%X", h2.Sum(nil)); s != g.out {
+			t.Fatalf("whirlpool(%q) after unmarshal = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+func TestMarshalPartialBits(t *testing.T) {
+	// Stop mid-byte after more than one block so the buffer holds stale data.
+	data := bytes.Repeat([]byte{0xa5}, 150)
+	h := whirlpool.New().(whirlpool.BitHash)
+	h.WriteBits(data, 8*100+5)
+
+	state, err := h.(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		t.Fatalf("could not marshal: %v", err)
+	}
+	h2 := whirlpool.New().(whirlpool.BitHash)
+	if err := h2.(encoding.BinaryUnmarshaler).UnmarshalBinary(state); err != nil {
+		t.Fatalf("could not unmarshal: %v", err)
+	}
+
+	h.WriteBits([]byte{0xff}, 3)
+	h2.WriteBits([]byte{0xff}, 3)
+	if s, want := fmt.Sprintf("%X", h2.Sum(nil)), fmt.Sprintf("%X", h.Sum(nil)); s != want {
+		t.Fatalf("whirlpool after unmarshal = %s want %s", s, want)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	h := whirlpool.New()
+	io.WriteString(h, "abc")
+	state, _ := h.(encoding.BinaryMarshaler).MarshalBinary()
+
+	corrupt := func(i int, b byte) []byte {
+		s := append([]byte(nil), state...)
+		s[i] ^= b
+		return s
+	}
+
+	tests := []struct {
+		name  string
+		state []byte
+		err   error
+	}{
+		{"empty", nil, whirlpool.ErrInvalidStateIdentifier},
+		{"bad magic", corrupt(0, 1), whirlpool.ErrInvalidStateIdentifier},
+		{"bad version", corrupt(5, 1), whirlpool.ErrInvalidStateIdentifier},
+		{"truncated", state[:len(state)-1], whirlpool.ErrInvalidStateSize},
+		{"trailing data", append(state[:len(state):len(state)], 0), whirlpool.ErrInvalidStateSize},
+		{"length mismatch", corrupt(len(state)-1, 8), whirlpool.ErrCorruptState},
+		{"stray buffer bits", corrupt(6+64+10, 1), whirlpool.ErrCorruptState},
+		{"buffer overflow", corrupt(6+64+64+6, 2), whirlpool.ErrCorruptState},
+	}
+	for _, tt := range tests {
+		err := whirlpool.New().(encoding.BinaryUnmarshaler).UnmarshalBinary(tt.state)
+		if err != tt.err {
+			t.Errorf("%s: UnmarshalBinary() = %v want %v", tt.name, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"crypto/hmac"
+	"encoding/binary"
+	"errors"
+	"hash"
+)
+
+const (
+	// VeraCryptSaltSize is the size of the salt that starts a VeraCrypt
+	// volume header.
+	VeraCryptSaltSize = 64
+
+	// VeraCryptKeySize is the number of bytes VeraCrypt derives for a header
+	// key, enough for a cascade of three ciphers in XTS mode.
+	VeraCryptKeySize = 192
+)
+
+// PBKDF2 derives a key of keyLen bytes from password and salt with PBKDF2
+// (RFC 8018) using HMAC-Whirlpool as the pseudorandom function and iter
+// iterations, which must be at least 1. The hash is computed with h, which is
+// New, or NewConstantTime when the password must not leak through cache
+// timing.
+func PBKDF2(password, salt []byte, iter, keyLen int, h func() hash.Hash) []byte {
+	prf := hmac.New(h, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var buf [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	U := make([]byte, hashLen)
+	for block := 1; block <= numBlocks; block++ {
+		// U_1 = PRF(password, salt || uint32(block)).
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(buf[:], uint32(block))
+		prf.Write(buf[:])
+		dk = prf.Sum(dk)
+		T := dk[len(dk)-hashLen:]
+		copy(U, T)
+
+		// T_block = U_1 ^ U_2 ^ ... ^ U_iter.
+		for n := 2; n <= iter; n++ {
+			prf.Reset()
+			prf.Write(U)
+			U = prf.Sum(U[:0])
+			for x := range U {
+				T[x] ^= U[x]
+			}
+		}
+	}
+	return dk[:keyLen]
+}
+
+// VeraCryptHeaderKey derives the key that decrypts the header of a VeraCrypt
+// volume which is not system encrypted, when Whirlpool is the selected PRF.
+// The salt is the first VeraCryptSaltSize bytes of the header. A pim of 0
+// uses the default of 500000 iterations, any other pim uses
+// 15000 + 1000*pim iterations. The key is derived with PBKDF2 and h; with
+// NewConstantTime and the default pim it takes well over a minute.
+func VeraCryptHeaderKey(password, salt []byte, pim int, h func() hash.Hash) ([]byte, error) {
+	if len(salt) != VeraCryptSaltSize {
+		return nil, errors.New("whirlpool: VeraCrypt salt must be 64 bytes")
+	}
+	if pim < 0 {
+		return nil, errors.New("whirlpool: negative VeraCrypt PIM")
+	}
+
+	iter := 500000
+	if pim > 0 {
+		iter = 15000 + 1000*pim
+	}
+	return PBKDF2(password, salt, iter, VeraCryptKeySize, h), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+type pbkdf2Test struct {
+	password string
+	salt     string
+	iter     int
+	out      string
+}
+
+// goldenPBKDF2 uses the inputs of RFC 6070. The expected values were checked
+// against the OpenSSL implementation of PBKDF2-HMAC-Whirlpool.
+var goldenPBKDF2 = []pbkdf2Test{
+	{"password", "salt", 1, "7E25009BF8AFADE8AB33911D331B5B3E987FC7C3E2D5FDB3F33C183E837C357850A75EB8BAAD2C05B1E3BC7068C2A2D5C0F3E586F401610AD02F525C8FCF2CBD"},
+	{"password", "salt", 2, "110B2E4266F03C334F6085BF421A68D6976A2F767E0BB6041A9C9315EC0D249FC8CB5FAC1F9F3B87DBB98E9B4B220DFE0D6B55F88109DD558C30F0A0356F7D9F"},
+	{"password", "salt", 4096, "4F4C0307915B7E3F948DAAF41EE7805CD2967513A3BE6975A7CCE782402598E6BD950C5051EA0C8185BEBA487B13EB93F5A93B8E2E1E7535643F00DD7C39CAD1"},
+	{"passwordPASSWORDpassword", "saltSALTsaltSALTsaltSALTsaltSALTsalt", 4096, "B704488BCC9371A5FA3A7EB6E7555549A96EAE3D572C0D505E1970F8460425D0CCC4CDB091F23082DA6F94D3E594012075443491B608D81AF37952C205403AD336267FF6AE039B0561731909FB35E5722BED8BC7F4805D62CB28239319CE9CB38D055FD2"},
+	{"pass\x00word", "sa\x00lt", 4096, "A5A8F2ABE3B0CD5A4084987DE2F6EF48"},
+}
+
+func TestGoldenPBKDF2(t *testing.T) {
+	for _, impl := range implementations {
+		for i, g := range goldenPBKDF2 {
+			if g.iter > 2 && impl.slow && testing.Short() {
+				continue
+			}
+			dk := whirlpool.PBKDF2([]byte(g.password), []byte(g.salt), g.iter, len(g.out)/2, impl.new)
+			if s := fmt.Sprintf("%X", dk); s != g.out {
+				t.Fatalf("PBKDF2-%s[%d](%q, %q, %d) = %s want %s", impl.name, i, g.password, g.salt, g.iter, s, g.out)
+			}
+		}
+	}
+}
+
+func veraCryptSalt() []byte {
+	salt := make([]byte, whirlpool.VeraCryptSaltSize)
+	for i := range salt {
+		salt[i] = byte(i)
+	}
+	return salt
+}
+
+func TestVeraCryptHeaderKey(t *testing.T) {
+	tests := []struct {
+		pim int
+		out string
+	}{
+		{1, "F49546E1F3CF686AA1E9D89A06CB40B95A6AABCD7E467F61DC4F5293506BCB95C0CA872B586593B8C00E5B3DDA240F41A6FE517B0CF25FB409A4887084D2C93D8E9FDCF63D5703DD793B5FE3CFB84338A515A4FE557407BEB4C219293DD0B217C38C452DBD652664D3D1A6F3A731EF7EE51EF71C171686F4E7B392869328C863927EAB829A0DACB72BFAB846C957FE865FE701F7C3365844286E174D8009CBC430745DC9C492874F2AC8C0D2B139BA5FFD10A076337860AC7316BB46964D8481"},
+		{0, "5BA0372EA19F8D3D3CEF447AB1C3EE60A072E8056DC3885FE67D6AA412A121E57AE3CD5E51EE9F12E7385C76CBC662AC87CF8935C14A33AB2E817E6F592342BB92D27BCDD60C27231C33D7C49B5E7A71BF4EDDC50160B40E102E78CA9FE35DC147FC500696A13B4312EE31110FE16D2843DC310E41569DE462E1D7EF73DD4260B7AA87A0ADEC2F141139DA28FA4596AD7468A88106AECFFCF56A182B3E1DCBCBAB9D13F08E481A1503599C6BF92409CF14C7CA339B857767313CE2F0D97E3915"},
+	}
+	for _, impl := range implementations {
+		for _, tt := range tests {
+			// The default pim takes over a minute without tables.
+			if impl.slow && (tt.pim == 0 || testing.Short()) {
+				continue
+			}
+			if tt.pim == 0 && testing.Short() {
+				continue
+			}
+			key, err := whirlpool.VeraCryptHeaderKey([]byte("password"), veraCryptSalt(), tt.pim, impl.new)
+			if err != nil {
+				t.Fatalf("VeraCryptHeaderKey-%s(pim=%d) returned %v", impl.name, tt.pim, err)
+			}
+			if s := fmt.Sprintf("%X", key); s != tt.out {
+				t.Fatalf("VeraCryptHeaderKey-%s(pim=%d) = %s want %s", impl.name, tt.pim, s, tt.out)
+			}
+		}
+	}
+}
+
+func TestVeraCryptHeaderKeyErrors(t *testing.T) {
+	if _, err := whirlpool.VeraCryptHeaderKey([]byte("password"), make([]byte, 32), 0, whirlpool.New); err == nil {
+		t.Error("VeraCryptHeaderKey accepted a 32-byte salt")
+	}
+	if _, err := whirlpool.VeraCryptHeaderKey([]byte("password"), veraCryptSalt(), -1, whirlpool.New); err == nil {
+		t.Error("VeraCryptHeaderKey accepted a negative PIM")
+	}
+}
+
+func BenchmarkPBKDF2(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		whirlpool.PBKDF2([]byte("password"), []byte("salt"), 1000, whirlpool.Size, whirlpool.New)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+)
+
+// TraceKind identifies the intermediate value reported by a TraceEvent.
+type TraceKind int
+
+const (
+	// TraceInput is the message block about to be compressed.
+	TraceInput TraceKind = iota
+
+	// TraceRoundKey is the round key K^r. K^0 is the hash state before the
+	// block is compressed.
+	TraceRoundKey
+
+	// TraceCipherState is the cipher state after round r. Round 0 is the
+	// block with K^0 applied.
+	TraceCipherState
+
+	// TraceHashState is the hash state after the Miyaguchi-Preneel
+	// compression of the block.
+	TraceHashState
+)
+
+func (k TraceKind) String() string {
+	switch k {
+	case TraceInput:
+		return "input block"
+	case TraceRoundKey:
+		return "round key"
+	case TraceCipherState:
+		return "cipher state"
+	case TraceHashState:
+		return "hash state"
+	}
+	return fmt.Sprintf("TraceKind(%d)", int(k))
+}
+
+// TraceEvent is an intermediate value of the compression function. For every
+// compressed block the events arrive in order: the input block, K^0 and the
+// cipher state of round 0, then K^r and the cipher state of each round r,
+// and finally the new hash state.
+type TraceEvent struct {
+	Kind  TraceKind
+	Round int       // Round of a TraceRoundKey or TraceCipherState, else 0.
+	Value [8]uint64 // Rows of the 8x8 byte matrix, big-endian.
+}
+
+// Bytes returns the value as a byte slice, row by row.
+func (ev TraceEvent) Bytes() []byte {
+	b := make([]byte, 0, 64)
+	for i := 0; i < 8; i++ {
+		b = binary.BigEndian.AppendUint64(b, ev.Value[i])
+	}
+	return b
+}
+
+// NewTraced returns a new hash.Hash computing the whirlpool checksum that
+// passes every intermediate value of the compression function to trace,
+// including those of the padding blocks compressed by Sum. The traced
+// compression function is separate from the one used by New, whose hashes
+// pay only a nil check per block for tracing.
+func NewTraced(trace func(TraceEvent)) hash.Hash {
+	return &whirlpool{v: &variantFinal, trace: trace}
+}
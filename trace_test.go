@@ -0,0 +1,214 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// traceRounds holds the round keys K^r and the cipher states after round r,
+// for r = 1 to 10, of the single block compressed when hashing the empty
+// string. They agree with the reference implementation in fuzz_test.go; see
+// TestTraceReference. They are not the intermediate values published with
+// the reference package, which are not in this tree; those should replace
+// them verbatim, with their source cited here.
+var traceRounds = [][2]string{
+	{
+		"300BEEC0AF9029672828282828282828282828282828282828282828282828282828282828282828282828282828282828282828282828282828282828282828",
+		"97ACC0678B3102CC0000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+	},
+	{
+		"3BAB89F8EAD1AE244445456645E9CBAF70FEA4A4C5A4B289C5FAA9E1E1CCE1A048ACC05CFCFCB8FC8FF70E26908F8F6996791407D7857979F8A8F868B8C878F8",
+		"CB5BE6084C662B5A64F1F104F15535A2CAEDC5C5D4C5E8D1E0726B9999B999321AF178469C9CAD9CE2CD6A84EFE2E248E18F82AE5ECD8F8F47E6478C61D30B47",
+	},
+	{
+		"D319BFDB30467058295B23D1AFCF37DB012C8AC28B95AC9881639EB1C0B206A7445E607AB0B209DB735B2CCFBC8CBC71DC670924EFEDDDD37B8D3BF0D73B7D19",
+		"FC7C4F8CBA8EDD8CEF814541D56DA9F6DADD443B850BCDAE0391BF64AE11FBC9494836B6BE1C2C7A9A9F87D44E6401FA8137F16AB1212CB1C6834959A2FBB30A",
+	},
+	{
+		"38BEAAC1DE116586687CF3D04A87337FF337FADB98ADF057C5E24258EE358DBC1109F0E8996E247E01C5D6ED10B03401FBC952F17B28ECD33256DC0CC7F12740",
+		"97AE0A491BC1FD7EA5BB3FAFA636C9A515A5904FC41B6BE959CAAD86E89A0DB97BBF5319C862593E676ED61AC4D9D2D1F0F3FEF9E3C6F0FB19BFB3F515248459",
+	},
+	{
+		"AF25A520949BCF14C13626A9E3C4534DE60F7D867740F9E1915DE6BBE26A0629965A54CC4CFE5E8DBEE931CB62323AA6B17B591896846A47D4F0C9362759AF31",
+		"877D1116AE31763828B6E8D0D2DCEB3BAF52E9E6C46B07E19D77DB687CA54E368D7355C1434DB85918547586955CA29A2EF283C814B673D2269BCB2248B3FE67",
+	},
+	{
+		"E2F9B5C025370BB0392BCBA2168494A5608AF8CEFA348C147AA53764418C9219B3F346A1FA833F8997493F487802CF7CDCADE8BA1E008F2392774F49EDB0323D",
+		"7024318995F273453AEA69DD4E59893F1B697E304807554F530BB6B9E444E528CAA10EAA19F2293DAAF46D522BBC55CF2BCDD13D87E679E8CEF2BC110D12F179",
+	},
+	{
+		"75416382774DFF2FFFFA38D055034600BF7D02493E98F361F4A860C29AE5CE0BC8DF5A44EE5D9D2723F45A55047500A4B016101202F9E28CAC30CD296833331D",
+		"D297B143752EB9749935E0C3712BBE46644289E09326492A0ECFBF36DD9F6CFAAFBA6CBB9255E3B6F5D5C1EAC1F42C988ACF2990737F00870EB6D08BB6D77142",
+	},
+	{
+		"036BF1826884AD899940C662D84671634C433E174B19C210E29CCFD34CFF86C521FF11A042DF26531B8E00CB6CE44B13A6123BF7A347B7CED918900E3B2833CA",
+		"B03B56CFBBF175E3BCA8F6B8B24B77DF68F88300D02355401D7C85341A2E8180417F44923D758A93888AEEC08C4F57AAE7A5126B21183BE20229527504A416B2",
+	},
+	{
+		"D01C677A0A9A2CF92A942F534A63B6B288422246FEACA8B4474A5CC73D58355974A6925DA55C6FA17717E68CC4735C39082A3B0B53EC1AC62AF658EB814DE762",
+		"0B72F2D562EDB4E1A12DE1D07189437F1BBF925F80D331E2CB0787C8A75B568965C87DE2B3F74994BC04F30AC926B82D3F397CC4C2ED9348E69D5AE2B6EAAA77",
+	},
+	{
+		"489548B601EEBC3AA50D6BC66BED8E81E0CE3DCF88265A75C28C4ADBC0F69CE954B79CD57F71851343414B8A977D0B7B631935BBDBF6157A6A7A4EF637018227",
+		"99FA61D75522A4669B44E39C1D2E1726C530232130D407F89AFEE0964997F7A73E83BE698B288FEBCF88E3E03C4F0757EA8964E59B63D93708B138CC42A66EB3",
+	},
+}
+
+func TestTrace(t *testing.T) {
+	var events []whirlpool.TraceEvent
+	h := whirlpool.NewTraced(func(ev whirlpool.TraceEvent) {
+		events = append(events, ev)
+	})
+	digest := fmt.Sprintf("%X", h.Sum(nil))
+
+	// One block: input, K^0 and state 0, ten rounds, hash state.
+	if len(events) != 1+2+2*len(traceRounds)+1 {
+		t.Fatalf("got %d trace events want %d", len(events), 1+2+2*len(traceRounds)+1)
+	}
+
+	expect := func(ev whirlpool.TraceEvent, kind whirlpool.TraceKind, round int, value string) {
+		t.Helper()
+		if ev.Kind != kind || ev.Round != round {
+			t.Fatalf("got %v of round %d want %v of round %d", ev.Kind, ev.Round, kind, round)
+		}
+		if s := fmt.Sprintf("%X", ev.Bytes()); s != value {
+			t.Fatalf("%v of round %d = %s want %s", kind, round, s, value)
+		}
+	}
+	zero := fmt.Sprintf("%0128X", 0)
+	input := "80" + zero[2:]
+	expect(events[0], whirlpool.TraceInput, 0, input)
+	expect(events[1], whirlpool.TraceRoundKey, 0, zero)
+	expect(events[2], whirlpool.TraceCipherState, 0, input)
+	for r, want := range traceRounds {
+		expect(events[3+2*r], whirlpool.TraceRoundKey, r+1, want[0])
+		expect(events[4+2*r], whirlpool.TraceCipherState, r+1, want[1])
+	}
+	expect(events[len(events)-1], whirlpool.TraceHashState, 0, digest)
+}
+
+func TestTraceGolden(t *testing.T) {
+	// Tracing must not change the result.
+	for _, g := range golden {
+		var last whirlpool.TraceEvent
+		h := whirlpool.NewTraced(func(ev whirlpool.TraceEvent) { last = ev })
+		h.Write([]byte(g.in))
+		if s := fmt.Sprintf("%X", h.Sum(nil)); s != g.out {
+			t.Fatalf("whirlpool(%s) = %s want %s", g.in, s, g.out)
+		}
+		if s := fmt.Sprintf("%X", last.Bytes()); last.Kind != whirlpool.TraceHashState || s != g.out {
+			t.Fatalf("last trace event of whirlpool(%s) = %v %s", g.in, last.Kind, s)
+		}
+	}
+}
+
+// TestTraceReference compares every traced value with the reference
+// implementation in fuzz_test.go, including messages of two and more
+// blocks.
+func TestTraceReference(t *testing.T) {
+	msgs := []string{
+		"",
+		"12345678901234567890123456789012345678901234567890123456789012345678901234567890",
+		strings.Repeat("abcdbcdecdefdefgefghfghighijhijk", 7),
+	}
+	for _, msg := range msgs {
+		var want []whirlpool.TraceEvent
+		event := func(kind whirlpool.TraceKind, round int, m refMatrix) {
+			ev := whirlpool.TraceEvent{Kind: kind, Round: round}
+			for i := range m {
+				ev.Value[i] = binary.BigEndian.Uint64(m[i][:])
+			}
+			want = append(want, ev)
+		}
+		var h refMatrix
+		for padded := refPad([]byte(msg), uint64(len(msg))*8); len(padded) > 0; padded = padded[64:] {
+			var m, state refMatrix
+			for i := 0; i < 64; i++ {
+				m[i/8][i%8] = padded[i]
+				state[i/8][i%8] = padded[i] ^ h[i/8][i%8]
+			}
+			event(whirlpool.TraceInput, 0, m)
+			event(whirlpool.TraceRoundKey, 0, h)
+			event(whirlpool.TraceCipherState, 0, state)
+			k := h
+			for r := 1; r <= 10; r++ {
+				var c refMatrix
+				for j := 0; j < 8; j++ {
+					c[0][j] = refS[8*(r-1)+j]
+				}
+				k = refRound(c, k)
+				state = refRound(k, state)
+				event(whirlpool.TraceRoundKey, r, k)
+				event(whirlpool.TraceCipherState, r, state)
+			}
+			for i := 0; i < 8; i++ {
+				for j := 0; j < 8; j++ {
+					h[i][j] ^= state[i][j] ^ m[i][j]
+				}
+			}
+			event(whirlpool.TraceHashState, 0, h)
+		}
+
+		var got []whirlpool.TraceEvent
+		w := whirlpool.NewTraced(func(ev whirlpool.TraceEvent) { got = append(got, ev) })
+		w.Write([]byte(msg))
+		w.Sum(nil)
+		if len(got) != len(want) {
+			t.Fatalf("whirlpool(%s) traced %d events want %d", msg, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("whirlpool(%s) event %d = %v of round %d %X want %v of round %d %X", msg, i,
+					got[i].Kind, got[i].Round, got[i].Bytes(), want[i].Kind, want[i].Round, want[i].Bytes())
+			}
+		}
+	}
+}
+
+// traceHashStates holds the hash state after each block, padding included,
+// of the multi-block messages of TestTraceReference. They were read from the
+// chaining value of OpenSSL's WHIRLPOOL_CTX after feeding it the padded
+// message one block at a time, so they do not depend on the code of this
+// package or of its tests.
+var traceHashStates = map[string][]string{
+	"12345678901234567890123456789012345678901234567890123456789012345678901234567890": {
+		"BA4E88AE3524077B7074F075E10EDBD63BF89F01D2AF53F2ED146C771AE3B3C6056AD68E30DB382506B04B42B85AB094437BAA4092D55EB13927D8119432C740",
+		"466EF18BABB0154D25B9D38A6414F5C08784372BCCB204D6549C4AFADB6014294D5BD8DF2A6C44E538CD047B2681A51A2C60481E88C5A20B2C2A80CF3A9A083B",
+	},
+	strings.Repeat("abcdbcdecdefdefgefghfghighijhijk", 7): {
+		"6E7A6375AD5A470D40A07974765854F0343272B8FCE48DA4BDB8CE0A00A150F31F0F03B69F1CE76A142BDC3597AF6230254681BC7407ED15EA302CB89AEDF779",
+		"A6D422AD8D2202539445FC56AC2C8B567676E75C308115E23D21F2143C055CDEC7396E648E18D24CDBB0C530403DD4FDB38ABA853C572EF1E8F174B50AE68E50",
+		"3DA27D286CDEDCF186F64162CEA05A248CADC0856B435DDC945E1A4AE910A084EE7D7EEB97F822B749FB06422DAAB9CD725816F71CC704F672B4C881DA8898B8",
+		"343C314989284F954E089BABC304ED9DF8F13ECAF7653B3FDEF5F0F9406B5806F7AB9ED95EB93657D93EEE985063FB41ACE444E2D5E7B6A2AA98110061968FD8",
+		"630B78370E7C361CC905EFB8C962948B54FDE80D13BA4016ECC8338552E98AF5346008455271AE304F30C050D8FA47E5F0BBC382DFE59445A04DB694B46F25C5",
+	},
+}
+
+func TestTraceHashStates(t *testing.T) {
+	for msg, want := range traceHashStates {
+		var got []string
+		w := whirlpool.NewTraced(func(ev whirlpool.TraceEvent) {
+			if ev.Kind == whirlpool.TraceHashState {
+				got = append(got, fmt.Sprintf("%X", ev.Bytes()))
+			}
+		})
+		w.Write([]byte(msg))
+		w.Sum(nil)
+		if len(got) != len(want) {
+			t.Fatalf("whirlpool(%s) traced %d hash states want %d", msg, len(got), len(want))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("whirlpool(%s) hash state after block %d = %s want %s", msg, i, got[i], want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+//go:generate go run gen_amd64.go -out transform_amd64.s
+
+package whirlpool
+
+// useAsm selects the assembly compression function. Tests turn it off to
+// compare against the generic code.
+//
+// There is no CPU feature detection because there is nothing to detect: the
+// routine uses only MOVQ, MOVBQZX, XORQ, BSWAPQ and loop arithmetic, which
+// every amd64 processor has. Whirlpool is bound by its 128 table loads per
+// round, and no optional extension (SSE4, AVX2, BMI2) removes those loads;
+// a vector gather is slower than eight scalar loads on current cores.
+var useAsm = true
+
+//go:noescape
+func transformAMD64(hash *[8]uint64, buf *[64]byte, c *[8]*[256]uint64, rc *[rounds + 1]uint64)
+
+// transformBlock compresses a block into the hash state.
+func (w *whirlpool) transformBlock(buf *[wblockBytes]byte) {
+	if w.v.constTime {
+		w.transformConstantTime(buf)
+		return
+	}
+	if w.trace != nil {
+		w.transformTraced(buf)
+		return
+	}
+	if useAsm {
+		transformAMD64(&w.hash, buf, &w.v.c, w.v.rc)
+		return
+	}
+	w.transformGeneric(buf)
+}
@@ -0,0 +1,53 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !purego
+
+package whirlpool
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// TestTransformAMD64 compares the assembly compression function against
+// the generic one on random states for every version of the hash.
+func TestTransformAMD64(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, v := range []*variant{&variantFinal, &variant0, &variantT, &variant256, &variant384} {
+		for i := 0; i < 1000; i++ {
+			w := whirlpool{v: v}
+			for j := range w.hash {
+				w.hash[j] = rnd.Uint64()
+			}
+			rnd.Read(w.buffer[:])
+
+			want := w
+			want.transformGeneric(&want.buffer)
+			transformAMD64(&w.hash, &w.buffer, &w.v.c, w.v.rc)
+			if w.hash != want.hash {
+				t.Fatalf("transformAMD64 = %x want %x", w.hash, want.hash)
+			}
+		}
+	}
+}
+
+// TestUseAsm hashes the same messages with and without the assembly.
+func TestUseAsm(t *testing.T) {
+	defer func() { useAsm = true }()
+
+	rnd := rand.New(rand.NewSource(2))
+	for n := 0; n < 1000; n += 7 {
+		msg := make([]byte, n)
+		rnd.Read(msg)
+
+		useAsm = true
+		got := Sum(msg)
+		useAsm = false
+		want := Sum(msg)
+		if got != want {
+			t.Fatalf("Sum(%d bytes) = %x with assembly, %x without", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !amd64 || purego
+
+package whirlpool
+
+// transformBlock compresses a block into the hash state.
+func (w *whirlpool) transformBlock(buf *[wblockBytes]byte) {
+	if w.v.constTime {
+		w.transformConstantTime(buf)
+		return
+	}
+	if w.trace != nil {
+		w.transformTraced(buf)
+		return
+	}
+	w.transformGeneric(buf)
+}
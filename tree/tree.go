@@ -0,0 +1,218 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package tree implements a tree hashing mode of whirlpool that spreads the
+// work of hashing large inputs over several goroutines.
+//
+// The input is split into leaves of LeafSize bytes; the last leaf may be
+// shorter, and an empty input has a single empty leaf. Each leaf is hashed
+// as
+//
+//	whirlpool(0x00 || leaf)
+//
+// Then, level by level, runs of up to FanOut consecutive digests are hashed
+// into the digests of the next level, from left to right, as
+//
+//	whirlpool(0x01 || digest_1 || ... || digest_k)
+//
+// until a single digest, the top, remains. The tree digest binds the top to
+// the parameters and the length of the input:
+//
+//	whirlpool(0x02 || be64(LeafSize) || be32(FanOut) || be64(length) || top)
+//
+// where beN is the big-endian encoding in N bits; FanOut is at most
+// MaxFanOut so that it fits. The prefix bytes separate the domains of
+// leaves, nodes and the final digest, so the input hashed for one of them
+// can never be mistaken for the input of another. The tree digest does not
+// depend on the number of goroutines.
+//
+// Each goroutine holds a buffer of LeafSize bytes, which is why LeafSize is
+// at most MaxLeafSize. It is at least MinLeafSize, one whirlpool block, so
+// that the leaf digests never take more memory than the input they cover.
+package tree
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// The parameters used when the Config leaves them unset.
+const (
+	DefaultLeafSize = 1 << 20
+	DefaultFanOut   = 16
+)
+
+// The limits of the parameters accepted by Sum.
+const (
+	MinLeafSize = whirlpool.BlockSize
+	MaxLeafSize = 1 << 26
+	MaxFanOut   = math.MaxUint32
+)
+
+// Domain separation prefixes of the hashed values.
+const (
+	leafPrefix  = 0x00
+	nodePrefix  = 0x01
+	finalPrefix = 0x02
+)
+
+// ErrInvalidConfig is returned when a Config has a negative field, a fan-out
+// of 1 or a parameter outside its limits.
+var ErrInvalidConfig = errors.New("tree: invalid configuration")
+
+// Config sets the shape of the tree and the parallelism used to hash it.
+// A zero field takes its default value.
+type Config struct {
+	LeafSize int // Bytes in each leaf, MinLeafSize to MaxLeafSize; DefaultLeafSize if zero.
+	FanOut   int // Children of each node, 2 to MaxFanOut; DefaultFanOut if zero.
+
+	// Workers is the number of goroutines that hash leaves;
+	// runtime.GOMAXPROCS(0) if zero. It does not change the digest.
+	Workers int
+}
+
+// Sum returns the tree digest of the first size bytes of r. A nil config
+// uses the default parameters. It fails with io.ErrUnexpectedEOF if r holds
+// fewer than size bytes.
+func Sum(r io.ReaderAt, size int64, config *Config) ([whirlpool.Size]byte, error) {
+	var c Config
+	if config != nil {
+		c = *config
+	}
+	if c.LeafSize < 0 || c.LeafSize > MaxLeafSize || c.LeafSize != 0 && c.LeafSize < MinLeafSize ||
+		c.FanOut < 0 || c.FanOut == 1 ||
+		int64(c.FanOut) > MaxFanOut || c.Workers < 0 || size < 0 {
+		return [whirlpool.Size]byte{}, ErrInvalidConfig
+	}
+	if c.LeafSize == 0 {
+		c.LeafSize = DefaultLeafSize
+	}
+	if c.FanOut == 0 {
+		c.FanOut = DefaultFanOut
+	}
+	if c.Workers == 0 {
+		c.Workers = runtime.GOMAXPROCS(0)
+	}
+
+	level, err := hashLeaves(r, size, &c)
+	if err != nil {
+		return [whirlpool.Size]byte{}, err
+	}
+
+	// Hash the levels of nodes up to the top.
+	h := whirlpool.New()
+	for len(level) > 1 {
+		next := make([][whirlpool.Size]byte, 0, (len(level)+c.FanOut-1)/c.FanOut)
+		for len(level) > 0 {
+			k := c.FanOut
+			if k > len(level) {
+				k = len(level)
+			}
+			h.Reset()
+			h.Write([]byte{nodePrefix})
+			for i := range level[:k] {
+				h.Write(level[i][:])
+			}
+			next = append(next, sum(h))
+			level = level[k:]
+		}
+		level = next
+	}
+
+	// Bind the top to the parameters and the length.
+	var header [1 + 8 + 4 + 8]byte
+	header[0] = finalPrefix
+	binary.BigEndian.PutUint64(header[1:], uint64(c.LeafSize))
+	binary.BigEndian.PutUint32(header[9:], uint32(c.FanOut))
+	binary.BigEndian.PutUint64(header[13:], uint64(size))
+	h.Reset()
+	h.Write(header[:])
+	h.Write(level[0][:])
+	return sum(h), nil
+}
+
+// hashLeaves returns the digests of the leaves of the first size bytes of
+// r, hashed by c.Workers goroutines.
+func hashLeaves(r io.ReaderAt, size int64, c *Config) ([][whirlpool.Size]byte, error) {
+	leafSize := int64(c.LeafSize)
+	n := (size + leafSize - 1) / leafSize
+	if n == 0 {
+		n = 1
+	}
+	digests := make([][whirlpool.Size]byte, n)
+
+	workers := c.Workers
+	if int64(workers) > n {
+		workers = int(n)
+	}
+
+	var (
+		next     int64 // Index of the next leaf to hash.
+		failed   int32 // Set once any worker has failed.
+		firstErr error
+		errOnce  sync.Once
+		wg       sync.WaitGroup
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			h := whirlpool.New()
+			buf := make([]byte, c.LeafSize)
+			for atomic.LoadInt32(&failed) == 0 {
+				i := atomic.AddInt64(&next, 1) - 1
+				if i >= n {
+					return
+				}
+				off := i * leafSize
+				leaf := buf
+				if size-off < leafSize {
+					leaf = buf[:size-off]
+				}
+				if err := readFull(r, leaf, off); err != nil {
+					errOnce.Do(func() { firstErr = err })
+					atomic.StoreInt32(&failed, 1)
+					return
+				}
+				h.Reset()
+				h.Write([]byte{leafPrefix})
+				h.Write(leaf)
+				digests[i] = sum(h)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return digests, nil
+}
+
+// readFull reads len(p) bytes of r at off into p.
+func readFull(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if n == len(p) {
+		// ReadAt may return io.EOF along with the last bytes.
+		return nil
+	}
+	if err == io.EOF || err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// sum returns the digest of h as an array.
+func sum(h hash.Hash) (digest [whirlpool.Size]byte) {
+	h.Sum(digest[:0])
+	return
+}
@@ -0,0 +1,155 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tree_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+	"github.com/jzelinskie/whirlpool/tree"
+)
+
+// spec computes the tree digest of data directly from the definition in the
+// package documentation, on a single goroutine.
+func spec(data []byte, leafSize, fanOut int) [whirlpool.Size]byte {
+	var level [][]byte
+	for off := 0; off == 0 || off < len(data); off += leafSize {
+		end := off + leafSize
+		if end > len(data) {
+			end = len(data)
+		}
+		d := whirlpool.Sum(append([]byte{0x00}, data[off:end]...))
+		level = append(level, d[:])
+	}
+	for len(level) > 1 {
+		var next [][]byte
+		for i := 0; i < len(level); i += fanOut {
+			msg := []byte{0x01}
+			for j := i; j < i+fanOut && j < len(level); j++ {
+				msg = append(msg, level[j]...)
+			}
+			d := whirlpool.Sum(msg)
+			next = append(next, d[:])
+		}
+		level = next
+	}
+	msg := []byte{0x02}
+	msg = binary.BigEndian.AppendUint64(msg, uint64(leafSize))
+	msg = binary.BigEndian.AppendUint32(msg, uint32(fanOut))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(data)))
+	return whirlpool.Sum(append(msg, level[0]...))
+}
+
+func TestSpec(t *testing.T) {
+	data := make([]byte, 4000)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	for _, n := range []int{0, 1, 63, 64, 65, 128, 129, 640, 1000, 4000} {
+		for _, shape := range [][2]int{{64, 2}, {64, 3}, {65, 16}, {100, 2}, {4000, 4}} {
+			want := spec(data[:n], shape[0], shape[1])
+			for _, workers := range []int{1, 2, 3, 8, 100} {
+				c := &tree.Config{LeafSize: shape[0], FanOut: shape[1], Workers: workers}
+				got, err := tree.Sum(bytes.NewReader(data), int64(n), c)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Fatalf("tree[%d, %v, %d workers] = %X want %X", n, shape, workers, got, want)
+				}
+			}
+		}
+	}
+}
+
+type treeTest struct {
+	out string
+	in  string
+}
+
+// golden pins the digests at the default parameters. They were checked by
+// building the hashed messages by hand and hashing them with OpenSSL.
+var golden = []treeTest{
+	{"A51B6289C00F8B09186464E6DA9BDD5C64AAE2218D7660ADD0C416397EAA6E86D83E29B705BB75B346AD297AC8E7B381C9C6F784A520EE884CFEDCA65A181F7F", ""},
+	{"8E6774C1B5E3C19CFA5C1C456FF03B3D1B70B52B0ED7C50B6326364140D60AFB7D4102B36A4EB2E9882FF585CF146B4EC47E4839C3CCBE59DB8292C6125EF382", "abc"},
+}
+
+func TestGolden(t *testing.T) {
+	for i, g := range golden {
+		got, err := tree.Sum(strings.NewReader(g.in), int64(len(g.in)), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := fmt.Sprintf("%X", got); s != g.out {
+			t.Fatalf("tree[%d](%s) = %s want %s", i, g.in, s, g.out)
+		}
+		if want := spec([]byte(g.in), tree.DefaultLeafSize, tree.DefaultFanOut); got != want {
+			t.Fatalf("tree[%d](%s) = %X want %X", i, g.in, got, want)
+		}
+	}
+}
+
+func TestShortInput(t *testing.T) {
+	c := &tree.Config{LeafSize: tree.MinLeafSize}
+	if _, err := tree.Sum(bytes.NewReader(make([]byte, 100)), 130, c); err != io.ErrUnexpectedEOF {
+		t.Fatalf("tree.Sum of a short input: err = %v want %v", err, io.ErrUnexpectedEOF)
+	}
+}
+
+type errReaderAt struct{ err error }
+
+func (r errReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	return 0, r.err
+}
+
+func TestReadError(t *testing.T) {
+	want := errors.New("read failed")
+	c := &tree.Config{LeafSize: tree.MinLeafSize, Workers: 4}
+	if _, err := tree.Sum(errReaderAt{want}, 1000, c); err != want {
+		t.Fatalf("tree.Sum: err = %v want %v", err, want)
+	}
+}
+
+func TestInvalidConfig(t *testing.T) {
+	configs := []tree.Config{
+		{LeafSize: -1}, {LeafSize: 1}, {LeafSize: tree.MinLeafSize - 1}, {LeafSize: tree.MaxLeafSize + 1},
+		{FanOut: 1}, {FanOut: -2}, {Workers: -1},
+	}
+	if strconv.IntSize == 64 {
+		// A fan-out that would be truncated to 2 in the final digest.
+		big := uint64(tree.MaxFanOut) + 3
+		configs = append(configs, tree.Config{FanOut: int(big)})
+	}
+	for _, c := range configs {
+		if _, err := tree.Sum(strings.NewReader(""), 0, &c); err != tree.ErrInvalidConfig {
+			t.Fatalf("tree.Sum(%+v): err = %v want %v", c, err, tree.ErrInvalidConfig)
+		}
+	}
+}
+
+func benchmarkWorkers(b *testing.B, workers int) {
+	data := make([]byte, 64<<20)
+	c := &tree.Config{Workers: workers}
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Sum(bytes.NewReader(data), int64(len(data)), c)
+	}
+}
+
+func BenchmarkWorkers1(b *testing.B) {
+	benchmarkWorkers(b, 1)
+}
+
+func BenchmarkWorkers4(b *testing.B) {
+	benchmarkWorkers(b, 4)
+}
@@ -0,0 +1,126 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool
+
+import "hash"
+
+// variant describes one version of the whirlpool hash function.
+type variant struct {
+	c         [8]*[256]uint64          // Lookup tables of the W cipher.
+	rc        *[rounds + 1]uint64      // Round constants of the W cipher.
+	iv        *[digestBytes / 8]uint64 // Initial hash state, or nil for zero.
+	size      int                      // Size of the digest in bytes.
+	magic     string                   // Identifier of marshaled states.
+	constTime bool                     // Compute without lookup tables.
+}
+
+// variantFinal is the final whirlpool of 2003, standardised in
+// ISO/IEC 10118-3.
+var variantFinal = variant{
+	c:     [8]*[256]uint64{&_C0, &_C1, &_C2, &_C3, &_C4, &_C5, &_C6, &_C7},
+	rc:    &rc,
+	size:  digestBytes,
+	magic: magic,
+}
+
+// variant0 is Whirlpool-0, the original submission to NESSIE in 2000.
+var variant0 = variant{
+	c:     [8]*[256]uint64{&_W0C[0], &_W0C[1], &_W0C[2], &_W0C[3], &_W0C[4], &_W0C[5], &_W0C[6], &_W0C[7]},
+	rc:    &_W0rc,
+	size:  digestBytes,
+	magic: magic0,
+}
+
+// variantT is Whirlpool-T, the 2001 revision that replaced the S-box of
+// Whirlpool-0 with the one of the final version.
+var variantT = variant{
+	c:     [8]*[256]uint64{&_WTC[0], &_WTC[1], &_WTC[2], &_WTC[3], &_WTC[4], &_WTC[5], &_WTC[6], &_WTC[7]},
+	rc:    &rc,
+	size:  digestBytes,
+	magic: magicT,
+}
+
+// variant256 and variant384 are truncated versions of the final whirlpool.
+// Their initial hash state is the whirlpool checksum of "Whirlpool-256" and
+// "Whirlpool-384" respectively, so that their digests are unrelated to
+// prefixes of the full digest.
+var (
+	variant256 = variant{
+		c:     variantFinal.c,
+		rc:    &rc,
+		iv:    &iv256,
+		size:  Size256,
+		magic: magic256,
+	}
+	variant384 = variant{
+		c:     variantFinal.c,
+		rc:    &rc,
+		iv:    &iv384,
+		size:  Size384,
+		magic: magic384,
+	}
+)
+
+var iv256 = [digestBytes / 8]uint64{
+	0x37b48e6730233ad8, 0x308805aa23affaeb, 0xd052afb31395c31f, 0x7dc93e92b77e1e51,
+	0x816660004fe1067f, 0xae52f36bbe4a52bc, 0x922350991b1517b0, 0x6f1851442e43d2c5,
+}
+
+var iv384 = [digestBytes / 8]uint64{
+	0xab6fa1146c343b77, 0xf689a4af79d9c9f8, 0xec5a8a90ac2c245f, 0xf5b1e4139ae6f626,
+	0xc5c246b1287fbab7, 0xff22e7f70988ed60, 0x7eaf786f721c94ba, 0xdbfef183adb50493,
+}
+
+// New0 returns a new hash.Hash computing the Whirlpool-0 checksum. It should
+// only be used to verify data hashed with that obsolete version.
+func New0() hash.Hash {
+	return &whirlpool{v: &variant0}
+}
+
+// NewT returns a new hash.Hash computing the Whirlpool-T checksum. It should
+// only be used to verify data hashed with that obsolete version.
+func NewT() hash.Hash {
+	return &whirlpool{v: &variantT}
+}
+
+// New256 returns a new hash.Hash computing the Whirlpool-256 checksum, a
+// whirlpool truncated to 32 bytes with its own initial state. It is not
+// standardised and its digests are not a prefix of the full checksum; use
+// SumPrefix256 for that.
+func New256() hash.Hash {
+	w := &whirlpool{v: &variant256}
+	w.Reset()
+	return w
+}
+
+// New384 returns a new hash.Hash computing the Whirlpool-384 checksum, a
+// whirlpool truncated to 48 bytes with its own initial state. It is not
+// standardised and its digests are not a prefix of the full checksum; use
+// SumPrefix384 for that.
+func New384() hash.Hash {
+	w := &whirlpool{v: &variant384}
+	w.Reset()
+	return w
+}
+
+// Sum256 returns the Whirlpool-256 checksum of the data. It is not the first
+// 32 bytes of the whirlpool checksum; use SumPrefix256 for that.
+func Sum256(data []byte) (sum [Size256]byte) {
+	w := whirlpool{v: &variant256, hash: iv256}
+	w.Write(data)
+	digest := w.checkSum()
+	copy(sum[:], digest[:])
+	return
+}
+
+// Sum384 returns the Whirlpool-384 checksum of the data. It is not the first
+// 48 bytes of the whirlpool checksum; use SumPrefix384 for that.
+func Sum384(data []byte) (sum [Size384]byte) {
+	w := whirlpool{v: &variant384, hash: iv384}
+	w.Write(data)
+	digest := w.checkSum()
+	copy(sum[:], digest[:])
+	return
+}
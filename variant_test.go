@@ -0,0 +1,139 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"encoding"
+	"fmt"
+	"hash"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// The empty and "quick brown fox" vectors are the published ones for each
+// version. The others were computed with an independent implementation
+// written from the specification.
+var golden0 = []whirlpoolTest{
+	{"B3E1AB6EAF640A34F784593F2074416ACCD3B8E62C620175FCA0997B1BA2347339AA0D79E754C308209EA36811DFA40C1C32F1A2B9004725D987D3635165D3C8", ""},
+	{"4F8F5CB531E3D49A61CF417CD133792CCFA501FD8DA53EE368FED20E5FE0248C3A0B64F98A6533CEE1DA614C3A8DDEC791FF05FEE6D971D57C1348320F4EB42D", "The quick brown fox jumps over the lazy dog"},
+	{"54EE18B0BBD4DD38A211699F2829793156E5842DF502A2A25995C6C541F28CC050FF57D4AF772DEE7CEDCC4C34C3B8EC06446C6657F2F36C2C06464399879B86", "abc"},
+	{"336ECA53CFE3B653C9607BEF5C748CF6C0D63871E9866530F09B262EB9682DB47D7BFA92269E1061A6D90AFC78FAAA4834A25AFAE185923CB0B26F4CC7716A0B", strings.Repeat("a", 200)},
+}
+
+var goldenT = []whirlpoolTest{
+	{"470F0409ABAA446E49667D4EBE12A14387CEDBD10DD17B8243CAD550A089DC0FEEA7AA40F6C2AAAB71C6EBD076E43C7CFCA0AD32567897DCB5969861049A0F5A", ""},
+	{"3CCF8252D8BBB258460D9AA999C06EE38E67CB546CFFCF48E91F700F6FC7C183AC8CC3D3096DD30A35B01F4620A1E3A20D79CD5168544D9E1B7CDF49970E87F1", "The quick brown fox jumps over the lazy dog"},
+	{"8AFC0527DCC0A19623860EF2369D0E25DE8EBE2ABAA40F598AFAF6B07C002ED73E4FC0FC220FD4F54F74B5D6B07AA57764C3DBDCC2CDD919D89FA8155A34B841", "abc"},
+	{"6165E6186191AEB7477126DD7D6E1B37108BC5484B701C8E6A58C6B265D80C1B46E7B96575B234BEBE63CD64D2C3753420A9C63D9D3047A471FB9EC352A7B5F0", strings.Repeat("a", 200)},
+}
+
+// Whirlpool-256 and Whirlpool-384 are specific to this package; their vectors
+// were computed with an independent implementation.
+var golden256 = []whirlpoolTest{
+	{"33D9B6E3F120EF6089336284F702967FD8957C5CC2E3D63B37A2DD2D05788058", ""},
+	{"3241428D76C60F1D2B5EB93C5B7E0C8C2DFCAA30EBEAF80489A5F99A6CDACE9B", "abc"},
+	{"FE46810ED588A02D7CA324EC1E798C36B72E74EB1EFD94E79F54DA1BB7C2D78C", "The quick brown fox jumps over the lazy dog"},
+	{"082D65F6E484F1C033BCEA56B64E9D324222A98FC7E207F0C7AFB9095859DC02", strings.Repeat("a", 200)},
+}
+
+var golden384 = []whirlpoolTest{
+	{"936236179978A8442F6A574D64202E2CB35C200B1BB8FD7D29D28E8213ACD230C1534735EFD930B2ECBA72754D563BD4", ""},
+	{"60705DC025DB6F0E8FC705B8BB96352F8F962298A646ACA4B59C22E8D98C6CA33AF409A0A29B726F5597BEDFF9C3FF3A", "abc"},
+	{"7371EDEE4BC3D4797B1A4D6F84848E82218152AA937112C886172D68169D2F82830A55822B987FF50B065AB27D2645C7", "The quick brown fox jumps over the lazy dog"},
+	{"38559E955F9BA4BF65E484D8AB1CDBFCE3B151CC45EFA8D451A4E811C3A084A48B4B7D059FA39B9B1E629A90471A9BE9", strings.Repeat("a", 200)},
+}
+
+func testGoldenVariant(t *testing.T, name string, newHash func() hash.Hash, golden []whirlpoolTest) {
+	for i := 0; i < len(golden); i++ {
+		g := golden[i]
+		c := newHash()
+		for j := 0; j < 3; j++ {
+			if j < 2 {
+				io.WriteString(c, g.in)
+			} else {
+				io.WriteString(c, g.in[0:len(g.in)/2])
+				c.Sum(nil)
+				io.WriteString(c, g.in[len(g.in)/2:])
+			}
+			s := fmt.Sprintf("%X", c.Sum(nil))
+			if s != g.out {
+				t.Fatalf("%s[%d](%s) = %s want %s", name, j, g.in, s, g.out)
+			}
+			c.Reset()
+		}
+	}
+}
+
+func TestGolden0(t *testing.T) {
+	testGoldenVariant(t, "whirlpool0", whirlpool.New0, golden0)
+}
+
+func TestGoldenT(t *testing.T) {
+	testGoldenVariant(t, "whirlpoolT", whirlpool.NewT, goldenT)
+}
+
+func TestGolden256(t *testing.T) {
+	testGoldenVariant(t, "whirlpool256", whirlpool.New256, golden256)
+	for _, g := range golden256 {
+		if s := fmt.Sprintf("%X", whirlpool.Sum256([]byte(g.in))); s != g.out {
+			t.Fatalf("Sum256(%s) = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+func TestGolden384(t *testing.T) {
+	testGoldenVariant(t, "whirlpool384", whirlpool.New384, golden384)
+	for _, g := range golden384 {
+		if s := fmt.Sprintf("%X", whirlpool.Sum384([]byte(g.in))); s != g.out {
+			t.Fatalf("Sum384(%s) = %s want %s", g.in, s, g.out)
+		}
+	}
+}
+
+func TestVariantSize(t *testing.T) {
+	tests := []struct {
+		h    hash.Hash
+		size int
+	}{
+		{whirlpool.New(), whirlpool.Size},
+		{whirlpool.New0(), whirlpool.Size},
+		{whirlpool.NewT(), whirlpool.Size},
+		{whirlpool.New256(), whirlpool.Size256},
+		{whirlpool.New384(), whirlpool.Size384},
+	}
+	for i, tt := range tests {
+		if n := tt.h.Size(); n != tt.size {
+			t.Errorf("[%d] Size() = %d want %d", i, n, tt.size)
+		}
+		if n := len(tt.h.Sum(nil)); n != tt.size {
+			t.Errorf("[%d] len(Sum(nil)) = %d want %d", i, n, tt.size)
+		}
+		if n := tt.h.BlockSize(); n != whirlpool.BlockSize {
+			t.Errorf("[%d] BlockSize() = %d want %d", i, n, whirlpool.BlockSize)
+		}
+	}
+}
+
+func TestMarshalVariantMismatch(t *testing.T) {
+	constructors := []func() hash.Hash{whirlpool.New, whirlpool.New0, whirlpool.NewT, whirlpool.New256, whirlpool.New384}
+	for i, from := range constructors {
+		state, err := from().(encoding.BinaryMarshaler).MarshalBinary()
+		if err != nil {
+			t.Fatalf("could not marshal: %v", err)
+		}
+		for j, to := range constructors {
+			err := to().(encoding.BinaryUnmarshaler).UnmarshalBinary(state)
+			if i == j && err != nil {
+				t.Errorf("UnmarshalBinary(%d -> %d) = %v want nil", i, j, err)
+			}
+			if i != j && err != whirlpool.ErrInvalidStateIdentifier {
+				t.Errorf("UnmarshalBinary(%d -> %d) = %v want %v", i, j, err, whirlpool.ErrInvalidStateIdentifier)
+			}
+		}
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2012 Jimmy Zelinskie. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package whirlpool_test
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/jzelinskie/whirlpool"
+)
+
+// vector is a test vector read from a file under testdata.
+type vector struct {
+	name       string // Position of the vector in its file.
+	msg        []byte // Message, left-justified if nbits is not a multiple of 8.
+	nbits      uint64 // Length of the message in bits.
+	hash       string // Expected digest in upper case hex.
+	iterations int    // Times the hash is applied for iterated, if set.
+	iterated   string // Expected digest of the iterated hash.
+}
+
+var (
+	nessieVector   = regexp.MustCompile(`^(Set \d+, vector# *\d+):$`)
+	nessieField    = regexp.MustCompile(`^ *([a-z0-9 ]+)=(.*)$`)
+	nessieHex      = regexp.MustCompile(`^ *([0-9A-F]+)$`)
+	nessieString   = regexp.MustCompile(`^"(.*)"( \(empty string\))?$`)
+	nessieRange    = regexp.MustCompile(`(.)\.\.\.(.)`)
+	nessieRepeat   = regexp.MustCompile(`^(\d+)( million)? times "(.*)"$`)
+	nessieZeros    = regexp.MustCompile(`^(\d+) zero bits$`)
+	nessieOneBit   = regexp.MustCompile(`^(\d+) bits with bit (\d+) set$`)
+	nessieIterated = regexp.MustCompile(`^iterated (\d+) times$`)
+)
+
+// parseNESSIE reads vectors in the layout of the NESSIE test vector files.
+// Lines outside of a vector, such as banners, are ignored.
+func parseNESSIE(r io.Reader) ([]vector, error) {
+	var (
+		vectors []vector
+		field   *string // The field that continuation lines extend.
+	)
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimRight(scanner.Text(), " ")
+		if m := nessieVector.FindStringSubmatch(text); m != nil {
+			vectors = append(vectors, vector{name: m[1]})
+			field = nil
+			continue
+		}
+		if len(vectors) == 0 || text == "" {
+			field = nil
+			continue
+		}
+		v := &vectors[len(vectors)-1]
+
+		if m := nessieHex.FindStringSubmatch(text); m != nil && field != nil {
+			*field += m[1]
+			continue
+		}
+		m := nessieField.FindStringSubmatch(text)
+		if m == nil {
+			field = nil
+			continue
+		}
+		switch name, value := m[1], m[2]; {
+		case name == "message":
+			if err := v.parseMessage(value); err != nil {
+				return nil, fmt.Errorf("line %d: %v", line, err)
+			}
+			field = nil
+		case name == "hash":
+			v.hash = value
+			field = &v.hash
+		case nessieIterated.MatchString(name):
+			v.iterations, _ = strconv.Atoi(nessieIterated.FindStringSubmatch(name)[1])
+			v.iterated = value
+			field = &v.iterated
+		default:
+			return nil, fmt.Errorf("line %d: unknown field %q", line, name)
+		}
+	}
+	return vectors, scanner.Err()
+}
+
+// parseMessage sets the message from its NESSIE description.
+func (v *vector) parseMessage(desc string) error {
+	switch {
+	case nessieString.MatchString(desc):
+		// Expand ranges such as "A...Z".
+		v.msg = []byte(nessieRange.ReplaceAllStringFunc(nessieString.FindStringSubmatch(desc)[1], func(r string) string {
+			var b []byte
+			for c := r[0]; c <= r[len(r)-1]; c++ {
+				b = append(b, c)
+			}
+			return string(b)
+		}))
+	case nessieRepeat.MatchString(desc):
+		m := nessieRepeat.FindStringSubmatch(desc)
+		n, _ := strconv.Atoi(m[1])
+		if m[2] != "" {
+			n *= 1000000
+		}
+		v.msg = bytes.Repeat([]byte(m[3]), n)
+	case nessieZeros.MatchString(desc):
+		n, _ := strconv.Atoi(nessieZeros.FindStringSubmatch(desc)[1])
+		v.msg = make([]byte, (n+7)/8)
+		v.nbits = uint64(n)
+		return nil
+	case nessieOneBit.MatchString(desc):
+		m := nessieOneBit.FindStringSubmatch(desc)
+		n, _ := strconv.Atoi(m[1])
+		bit, _ := strconv.Atoi(m[2])
+		if bit >= n {
+			return fmt.Errorf("bit %d of a %d-bit message", bit, n)
+		}
+		v.msg = make([]byte, (n+7)/8)
+		v.msg[bit/8] = 0x80 >> uint(bit%8)
+		v.nbits = uint64(n)
+		return nil
+	default:
+		return fmt.Errorf("unknown message %q", desc)
+	}
+	v.nbits = uint64(len(v.msg)) * 8
+	return nil
+}
+
+var (
+	isoExample   = regexp.MustCompile(`^(\d+)\. `)
+	isoString    = regexp.MustCompile(`'([^']*)'`)
+	isoLength    = regexp.MustCompile(`(\d+)-byte`)
+	isoHashIntro = regexp.MustCompile(`hash-code is`)
+	isoHashCode  = regexp.MustCompile(`^[0-9A-F ]+$`)
+)
+
+// parseISO reads vectors in the layout of the examples of ISO/IEC 10118-3:
+// numbered paragraphs describing the data-string, each followed by a
+// paragraph introducing the hash-code and the hash-code in hex. The
+// data-string is the quoted text, repeated to fill the stated number of
+// bytes, or empty if nothing is quoted. Other hex paragraphs, such as
+// intermediate values, are ignored.
+func parseISO(r io.Reader) ([]vector, error) {
+	var (
+		vectors []vector
+		para    []string
+		intro   bool // The last paragraph introduced a hash-code.
+	)
+	flush := func() error {
+		text := strings.Join(para, " ")
+		para = para[:0]
+		if text == "" {
+			return nil
+		}
+		wasIntro := intro
+		intro = isoHashIntro.MatchString(text)
+		switch {
+		case isoExample.MatchString(text):
+			v := vector{name: "example " + isoExample.FindStringSubmatch(text)[1]}
+			if m := isoString.FindStringSubmatch(text); m != nil {
+				v.msg = []byte(m[1])
+				if n := isoLength.FindStringSubmatch(text); n != nil {
+					size, _ := strconv.Atoi(n[1])
+					if len(v.msg) == 0 || size%len(v.msg) != 0 {
+						return fmt.Errorf("%s: %d bytes of %q", v.name, size, m[1])
+					}
+					v.msg = bytes.Repeat(v.msg, size/len(v.msg))
+				}
+			}
+			v.nbits = uint64(len(v.msg)) * 8
+			vectors = append(vectors, v)
+		case wasIntro && len(vectors) > 0 && isoHashCode.MatchString(text):
+			vectors[len(vectors)-1].hash = strings.ReplaceAll(text, " ", "")
+		}
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if text := strings.TrimSpace(scanner.Text()); text != "" {
+			para = append(para, text)
+			continue
+		}
+		if err := flush(); err != nil {
+			return nil, err
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return vectors, scanner.Err()
+}
+
+// testVectors checks the vectors of file, which must hold at least count
+// vectors. It skips the test if the file has not been added to testdata.
+func testVectors(t *testing.T, file string, parse func(io.Reader) ([]vector, error), count int) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		t.Skipf("%s is missing; copy it from the Whirlpool reference package", file)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	vectors, err := parse(f)
+	if err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+	if len(vectors) < count {
+		t.Fatalf("%s: read %d vectors want at least %d", file, len(vectors), count)
+	}
+
+	for _, v := range vectors {
+		if len(v.hash) != 2*whirlpool.Size {
+			t.Fatalf("%s: %s has a %d-digit hash", file, v.name, len(v.hash))
+		}
+		if testing.Short() && len(v.msg) > 1<<16 {
+			continue
+		}
+		c := whirlpool.New().(whirlpool.BitHash)
+		c.WriteBits(v.msg, v.nbits)
+		digest := c.Sum(nil)
+		if s := fmt.Sprintf("%X", digest); s != v.hash {
+			t.Fatalf("%s: whirlpool(%s) = %s want %s", file, v.name, s, v.hash)
+		}
+
+		if v.iterations == 0 || testing.Short() {
+			continue
+		}
+		for i := 1; i < v.iterations; i++ {
+			d := whirlpool.Sum(digest)
+			digest = d[:]
+		}
+		if s := fmt.Sprintf("%X", digest); s != v.iterated {
+			t.Fatalf("%s: whirlpool(%s) iterated %d times = %s want %s", file, v.name, v.iterations, s, v.iterated)
+		}
+	}
+}
+
+// TestNESSIE checks the NESSIE test vectors for Whirlpool, as shipped in the
+// Whirlpool reference package linked from the page cited in the package
+// documentation. Sets 1 to 4 hold byte strings up to a million bytes, 0 to
+// 1023 zero bits, the 512 one-bit messages of a block, and 512 zero bits
+// hashed 100000000 times. The file must be copied verbatim to testdata. The
+// long and iterated vectors are skipped in short mode; the iterated one
+// takes about two minutes on amd64.
+func TestNESSIE(t *testing.T) {
+	testVectors(t, "testdata/nessie-whirlpool.txt", parseNESSIE, 9+1024+512+1)
+}
+
+// TestISO checks the hash-codes of the examples of ISO/IEC 10118-3 for
+// Whirlpool, as shipped in the Whirlpool reference package. The file must be
+// copied verbatim to testdata.
+func TestISO(t *testing.T) {
+	testVectors(t, "testdata/iso-whirlpool.txt", parseISO, 1)
+}
+
+// TestParseNESSIE checks the parser on an excerpt in the NESSIE layout, so
+// that it is exercised even when the vector files are missing.
+func TestParseNESSIE(t *testing.T) {
+	const text = `Test vectors -- set 1
+=====================
+
+Set 1, vector#  0:
+                       message="" (empty string)
+                          hash=19FA61D75522A4669B44E39C1D2E1726
+                               C530232130D407F89AFEE0964997F7A7
+                               3E83BE698B288FEBCF88E3E03C4F0757
+                               EA8964E59B63D93708B138CC42A66EB3
+
+Set 3, vector#  9:
+                       message=512 bits with bit 9 set
+                          hash=0123
+
+Set 4, vector#  0:
+                       message=512 zero bits
+                          hash=4567
+         iterated 100000000 times=89AB
+                               CDEF
+
+End of test vectors
+`
+	vectors, err := parseNESSIE(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 3 {
+		t.Fatalf("parseNESSIE read %d vectors want 3", len(vectors))
+	}
+	if v := vectors[0]; v.name != "Set 1, vector#  0" || len(v.msg) != 0 || v.nbits != 0 || v.hash != golden[0].out {
+		t.Fatalf("parseNESSIE read %+v", v)
+	}
+	if v := vectors[1]; v.nbits != 512 || len(v.msg) != 64 || v.msg[1] != 0x40 || v.hash != "0123" {
+		t.Fatalf("parseNESSIE read %+v", v)
+	}
+	if v := vectors[2]; v.nbits != 512 || !bytes.Equal(v.msg, make([]byte, 64)) || v.hash != "4567" ||
+		v.iterations != 100000000 || v.iterated != "89ABCDEF" {
+		t.Fatalf("parseNESSIE read %+v", v)
+	}
+}
+
+// TestParseISOIntermediate checks that hex paragraphs other than the
+// hash-code, such as the intermediate values of the published examples, do
+// not replace it.
+func TestParseISOIntermediate(t *testing.T) {
+	const text = `1. In this example the data-string is the empty string.
+
+The hash-code is the following 512-bit string.
+
+19FA61D7 5522A466 9B44E39C 1D2E1726 C5302321 30D407F8 9AFEE096 4997F7A7
+3E83BE69 8B288FEB CF88E3E0 3C4F0757 EA8964E5 9B63D937 08B138CC 42A66EB3
+
+The intermediate values after the first round are:
+
+00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000
+`
+	vectors, err := parseISO(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	const want = "19FA61D75522A4669B44E39C1D2E1726C530232130D407F89AFEE0964997F7A73E83BE698B288FEBCF88E3E03C4F0757EA8964E59B63D93708B138CC42A66EB3"
+	if len(vectors) != 1 || vectors[0].hash != want {
+		t.Fatalf("parseISO read %+v want one vector with hash %s", vectors, want)
+	}
+}
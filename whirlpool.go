@@ -5,12 +5,25 @@
 // Package whirlpool implements the ISO/IEC 10118-3:2004 whirlpool
 // cryptographic hash. Whirlpool is defined in
 // http://www.larc.usp.br/~pbarreto/WhirlpoolPage.html
+//
+// The package requires Go 1.25 or later for hash.Cloner.
 package whirlpool
 
-import (
-	"encoding/binary"
-	"hash"
-)
+//go:generate go run gen_transform.go -out transform.go
+
+import "hash"
+
+// The size of a whirlpool checksum in bytes.
+const Size = digestBytes
+
+// The size of a Whirlpool-256 checksum in bytes.
+const Size256 = 32
+
+// The size of a Whirlpool-384 checksum in bytes.
+const Size384 = 48
+
+// The blocksize of whirlpool in bytes.
+const BlockSize = wblockBytes
 
 // whirlpool represents the partial evaluation of a checksum.
 type whirlpool struct {
@@ -19,11 +32,52 @@ type whirlpool struct {
 	bufferBits int                     // Current number of bits on the buffer.
 	bufferPos  int                     // Current byte location on buffer.
 	hash       [digestBytes / 8]uint64 // Hash state.
+	v          *variant                // Version of the hash function.
+	trace      func(TraceEvent)        // Receives intermediate values, if set.
+}
+
+// BitHash is a hash.Hash that also accepts messages whose length is not a
+// multiple of 8 bits, as permitted by ISO/IEC 10118-3.
+type BitHash interface {
+	hash.Hash
+
+	// WriteBits adds the first nbits bits of data to the running hash,
+	// most significant bit first.
+	WriteBits(data []byte, nbits uint64)
 }
 
-// New returns a new hash.Hash computing the whirlpool checksum.
+// New returns a new hash.Hash computing the whirlpool checksum. The returned
+// value also implements BitHash, hash.Cloner to fork the running hash, and
+// encoding.BinaryMarshaler, encoding.BinaryAppender and
+// encoding.BinaryUnmarshaler to save and restore the internal state of the
+// hash.
 func New() hash.Hash {
-	return new(whirlpool)
+	return &whirlpool{v: &variantFinal}
+}
+
+// Sum returns the whirlpool checksum of the data.
+func Sum(data []byte) [Size]byte {
+	w := whirlpool{v: &variantFinal}
+	w.Write(data)
+	return w.checkSum()
+}
+
+// SumPrefix256 returns the first 32 bytes of the whirlpool checksum of the
+// data. It is not the Whirlpool-256 checksum, which starts from its own
+// initial state; use Sum256 for that.
+func SumPrefix256(data []byte) (sum [Size256]byte) {
+	digest := Sum(data)
+	copy(sum[:], digest[:])
+	return
+}
+
+// SumPrefix384 returns the first 48 bytes of the whirlpool checksum of the
+// data. It is not the Whirlpool-384 checksum, which starts from its own
+// initial state; use Sum384 for that.
+func SumPrefix384(data []byte) (sum [Size384]byte) {
+	digest := Sum(data)
+	copy(sum[:], digest[:])
+	return
 }
 
 func (w *whirlpool) Reset() {
@@ -32,107 +86,89 @@ func (w *whirlpool) Reset() {
 	w.bufferBits = 0
 	w.bufferPos = 0
 
-	// Cleanup the digest.
-	w.hash = [digestBytes / 8]uint64{}
+	// Restore the initial digest.
+	if w.v.iv != nil {
+		w.hash = *w.v.iv
+	} else {
+		w.hash = [digestBytes / 8]uint64{}
+	}
 
 	// Clean up the number of hashed bits.
 	w.bitLength = [lengthBytes]byte{}
 }
 
 func (w *whirlpool) Size() int {
-	return digestBytes
+	return w.v.size
 }
 
 func (w *whirlpool) BlockSize() int {
 	return wblockBytes
 }
 
-func (w *whirlpool) transform() {
-	var (
-		K     [8]uint64 // Round key.
-		block [8]uint64 // μ(buffer).
-		state [8]uint64 // Cipher state.
-		L     [8]uint64
-	)
-
-	// Map the buffer to a block.
-	for i := 0; i < 8; i++ {
-		b := 8 * i
-		block[i] = binary.BigEndian.Uint64(w.buffer[b:])
-	}
-
-	// Compute & apply K^0 to the cipher state.
-	for i := 0; i < 8; i++ {
-		K[i] = w.hash[i]
-		state[i] = block[i] ^ K[i]
+func (w *whirlpool) Write(source []byte) (int, error) {
+	n := len(source)
+	if w.bufferBits&7 != 0 {
+		// The buffer ends mid-byte, so every byte has to be shifted.
+		w.write(source, uint64(n)*8)
+		return n, nil
 	}
-
-	// Iterate over all the rounds.
-	for r := 1; r <= rounds; r++ {
-		// Compute K^rounds from K^(rounds-1).
-		for i := 0; i < 8; i++ {
-			L[i] = _C0[byte(K[i%8]>>56)] ^
-				_C1[byte(K[(i+7)%8]>>48)] ^
-				_C2[byte(K[(i+6)%8]>>40)] ^
-				_C3[byte(K[(i+5)%8]>>32)] ^
-				_C4[byte(K[(i+4)%8]>>24)] ^
-				_C5[byte(K[(i+3)%8]>>16)] ^
-				_C6[byte(K[(i+2)%8]>>8)] ^
-				_C7[byte(K[(i+1)%8])]
+	w.addLength(uint64(n) * 8)
+
+	// Complete a partially filled buffer.
+	if w.bufferPos > 0 {
+		c := copy(w.buffer[w.bufferPos:], source)
+		w.bufferPos += c
+		source = source[c:]
+		if w.bufferPos == wblockBytes {
+			w.transform()
+			w.bufferPos = 0
 		}
-		L[0] ^= rc[r]
+	}
 
-		for i := 0; i < 8; i++ {
-			K[i] = L[i]
-		}
+	// Hash whole blocks straight from the source.
+	if len(source) >= wblockBytes {
+		blocks := len(source) &^ (wblockBytes - 1)
+		w.transformBlocks(source[:blocks])
+		source = source[blocks:]
+	}
 
-		// Apply r-th round transformation.
-		for i := 0; i < 8; i++ {
-			L[i] = _C0[byte(state[i%8]>>56)] ^
-				_C1[byte(state[(i+7)%8]>>48)] ^
-				_C2[byte(state[(i+6)%8]>>40)] ^
-				_C3[byte(state[(i+5)%8]>>32)] ^
-				_C4[byte(state[(i+4)%8]>>24)] ^
-				_C5[byte(state[(i+3)%8]>>16)] ^
-				_C6[byte(state[(i+2)%8]>>8)] ^
-				_C7[byte(state[(i+1)%8])] ^
-				K[i%8]
-		}
+	// Keep the rest on the buffer. The byte after the data must be clear for
+	// later bit-granular writes and padding.
+	w.bufferPos += copy(w.buffer[w.bufferPos:], source)
+	w.bufferBits = 8 * w.bufferPos
+	w.buffer[w.bufferPos] = 0
+	return n, nil
+}
 
-		for i := 0; i < 8; i++ {
-			state[i] = L[i]
-		}
+// WriteBits adds the first nbits bits of data to the running hash. Bits are
+// consumed most significant first, so the final partial byte of data, if any,
+// is left-justified. It panics if nbits exceeds 8*len(data).
+func (w *whirlpool) WriteBits(data []byte, nbits uint64) {
+	if nbits > uint64(len(data))*8 {
+		panic("whirlpool: nbits exceeds the length of data")
 	}
-
-	// Apply the Miyaguchi-Preneel compression function.
-	for i := 0; i < 8; i++ {
-		w.hash[i] ^= state[i] ^ block[i]
+	if nbits%8 == 0 {
+		w.Write(data[:nbits/8])
+		return
 	}
+	w.write(data, nbits)
 }
 
-func (w *whirlpool) Write(source []byte) (int, error) {
+// write adds sourceBits bits of source to the running hash.
+func (w *whirlpool) write(source []byte, sourceBits uint64) {
 	var (
-		sourcePos  int                                            // Index of the leftmost source.
-		nn         int    = len(source)                           // Num of bytes to process.
-		sourceBits uint64 = uint64(nn * 8)                        // Num of bits to process.
-		sourceGap  uint   = uint((8 - (int(sourceBits & 7))) & 7) // Space on source[sourcePos].
-		bufferRem  uint   = uint(w.bufferBits & 7)                // Occupied bits on buffer[bufferPos].
-		b          uint32                                         // Current byte.
+		sourcePos int                      // Index of the leftmost source.
+		bufferRem = uint(w.bufferBits & 7) // Occupied bits on buffer[bufferPos].
+		b         uint32                   // Current byte.
 	)
 
 	// Tally the length of the data added.
-	for i, carry, value := 31, uint32(0), uint64(sourceBits); i >= 0 && (carry != 0 || value != 0); i-- {
-		carry += uint32(w.bitLength[i]) + (uint32(value & 0xff))
-		w.bitLength[i] = byte(carry)
-		carry >>= 8
-		value >>= 8
-	}
+	w.addLength(sourceBits)
 
 	// Process data in chunks of 8 bits.
 	for sourceBits > 8 {
 		// Take a byte form the source.
-		b = uint32(((source[sourcePos] << sourceGap) & 0xff) |
-			((source[sourcePos+1] & 0xff) >> (8 - sourceGap)))
+		b = uint32(source[sourcePos])
 
 		// Process this byte.
 		w.buffer[w.bufferPos] |= uint8(b >> bufferRem)
@@ -156,7 +192,8 @@ func (w *whirlpool) Write(source []byte) (int, error) {
 
 	// 0 <= sourceBits <= 8; All data leftover is in source[sourcePos].
 	if sourceBits > 0 {
-		b = uint32((source[sourcePos] << sourceGap) & 0xff) // The bits are left-justified.
+		// The bits are left-justified; drop anything past the end.
+		b = uint32(source[sourcePos] & (0xff << (8 - sourceBits)))
 
 		// Process the remaining bits.
 		w.buffer[w.bufferPos] |= byte(b) >> bufferRem
@@ -184,57 +221,92 @@ func (w *whirlpool) Write(source []byte) (int, error) {
 		w.buffer[w.bufferPos] = byte(b << (8 - bufferRem))
 		w.bufferBits += int(sourceBits)
 	}
-	return nn, nil
+}
+
+// addLength adds bits to the number of hashed bits.
+func (w *whirlpool) addLength(bits uint64) {
+	for i, carry, value := 31, uint32(0), bits; i >= 0 && (carry != 0 || value != 0); i-- {
+		carry += uint32(w.bitLength[i]) + (uint32(value & 0xff))
+		w.bitLength[i] = byte(carry)
+		carry >>= 8
+		value >>= 8
+	}
+}
+
+// transform compresses the block in the buffer into the hash state.
+func (w *whirlpool) transform() {
+	w.transformBlock(&w.buffer)
+}
+
+// transformBlocks compresses the whole blocks of p into the hash state.
+func (w *whirlpool) transformBlocks(p []byte) {
+	for len(p) >= wblockBytes {
+		w.transformBlock((*[wblockBytes]byte)(p))
+		p = p[wblockBytes:]
+	}
+}
+
+// Clone returns a copy of the running hash. The copy and the original can be
+// written, summed and reset independently.
+func (w *whirlpool) Clone() (hash.Cloner, error) {
+	c := *w
+	return &c, nil
 }
 
 func (w *whirlpool) Sum(in []byte) []byte {
 	// Copy the whirlpool so that the caller can keep summing.
 	n := *w
+	digest := n.checkSum()
+	return append(in, digest[:w.v.size]...)
+}
 
+// checkSum pads the message and returns the final digest. It modifies w, so
+// callers that want to keep summing must work on a copy.
+func (w *whirlpool) checkSum() [digestBytes]byte {
 	// Append a 1-bit.
-	n.buffer[n.bufferPos] |= 0x80 >> (uint(n.bufferBits) & 7)
-	n.bufferPos++
+	w.buffer[w.bufferPos] |= 0x80 >> (uint(w.bufferBits) & 7)
+	w.bufferPos++
 
 	// The remaining bits should be 0. Pad with 0s to be complete.
-	if n.bufferPos > wblockBytes-lengthBytes {
-		if n.bufferPos < wblockBytes {
-			for i := 0; i < wblockBytes-n.bufferPos; i++ {
-				n.buffer[n.bufferPos+i] = 0
+	if w.bufferPos > wblockBytes-lengthBytes {
+		if w.bufferPos < wblockBytes {
+			for i := 0; i < wblockBytes-w.bufferPos; i++ {
+				w.buffer[w.bufferPos+i] = 0
 			}
 		}
 		// Process this data block.
-		n.transform()
+		w.transform()
 		// Reset the buffer.
-		n.bufferPos = 0
+		w.bufferPos = 0
 	}
 
-	if n.bufferPos < wblockBytes-lengthBytes {
-		for i := 0; i < (wblockBytes-lengthBytes)-n.bufferPos; i++ {
-			n.buffer[n.bufferPos+i] = 0
+	if w.bufferPos < wblockBytes-lengthBytes {
+		for i := 0; i < (wblockBytes-lengthBytes)-w.bufferPos; i++ {
+			w.buffer[w.bufferPos+i] = 0
 		}
 	}
-	n.bufferPos = wblockBytes - lengthBytes
+	w.bufferPos = wblockBytes - lengthBytes
 
 	// Append the bit length of the hashed data.
 	for i := 0; i < lengthBytes; i++ {
-		n.buffer[n.bufferPos+i] = n.bitLength[i]
+		w.buffer[w.bufferPos+i] = w.bitLength[i]
 	}
 
 	// Process this data block.
-	n.transform()
+	w.transform()
 
-	// Return the final digest as []byte.
+	// Return the final digest.
 	var digest [digestBytes]byte
 	for i := 0; i < digestBytes/8; i++ {
-		digest[i*8] = byte(n.hash[i] >> 56)
-		digest[i*8+1] = byte(n.hash[i] >> 48)
-		digest[i*8+2] = byte(n.hash[i] >> 40)
-		digest[i*8+3] = byte(n.hash[i] >> 32)
-		digest[i*8+4] = byte(n.hash[i] >> 24)
-		digest[i*8+5] = byte(n.hash[i] >> 16)
-		digest[i*8+6] = byte(n.hash[i] >> 8)
-		digest[i*8+7] = byte(n.hash[i])
+		digest[i*8] = byte(w.hash[i] >> 56)
+		digest[i*8+1] = byte(w.hash[i] >> 48)
+		digest[i*8+2] = byte(w.hash[i] >> 40)
+		digest[i*8+3] = byte(w.hash[i] >> 32)
+		digest[i*8+4] = byte(w.hash[i] >> 24)
+		digest[i*8+5] = byte(w.hash[i] >> 16)
+		digest[i*8+6] = byte(w.hash[i] >> 8)
+		digest[i*8+7] = byte(w.hash[i])
 	}
 
-	return append(in, digest[:digestBytes]...)
+	return digest
 }
@@ -5,7 +5,9 @@
 package whirlpool_test
 
 import (
+	"bytes"
 	"fmt"
+	"hash"
 	"io"
 	"testing"
 
@@ -72,6 +74,25 @@ func TestGolden(t *testing.T) {
 	}
 }
 
+// TestGoldenSplits writes the golden messages in three pieces, split at
+// every pair of points.
+func TestGoldenSplits(t *testing.T) {
+	for _, g := range golden {
+		c := whirlpool.New()
+		for i := 0; i <= len(g.in); i++ {
+			for j := i; j <= len(g.in); j++ {
+				c.Reset()
+				io.WriteString(c, g.in[:i])
+				io.WriteString(c, g.in[i:j])
+				io.WriteString(c, g.in[j:])
+				if s := fmt.Sprintf("%X", c.Sum(nil)); s != g.out {
+					t.Fatalf("whirlpool[%d:%d](%s) = %s want %s", i, j, g.in, s, g.out)
+				}
+			}
+		}
+	}
+}
+
 func ExampleNew() {
 	h := whirlpool.New()
 	io.WriteString(h, "His money is twice tainted: 'taint yours and 'taint mine.")
@@ -79,3 +100,251 @@ func ExampleNew() {
 	// Output:
 	// 63 6c e4 b3 3a 67 4a af 0b b4 e2 37 1d 85 b1 16 01 9d 57 08 dc 64 34 f6 db 9a 45 51 51 98 1f 2c 36 d7 c5 00 b0 40 af 09 ec 2d 5a 39 40 18 eb 65 6a e2 13 56 56 43 18 a0 fe 8f 0d 1c 1b 67 50 ec
 }
+
+type whirlpoolBitTest struct {
+	nbits uint64
+	out   string
+}
+
+// goldenBits holds the NESSIE Set 2 vectors: messages of nbits zero bits.
+var goldenBits = []whirlpoolBitTest{
+	{1, "E384D540E0BDFD28C8529177343B31183FB40C20F960B0BCDCE0513A382F96A3832099EBB6AABDB71B0EA2E30177F698EA703DE51F93CF3CFEA6D3171B955383"},
+	{2, "D5FC92DDBCDD452A90871CCBBCE5E65DC1EFFD33665BF00B09659CA98F085B231647A0034B4B3DC0C8BF589FC1153E9D98B9DC05B881E530E8AD9790ABA57ED7"},
+	{3, "0C9EBAE407F8A2D7AE322AEECBBE43844B159FDDA69573E474F067F76F60686EBB345FCA544A9411E12AEF7B609A107809B84CA214BB91A1E9DCD03CFFB1EE60"},
+	{4, "22793E0492650080C35FA95D827FB925D50FA66A06BC4E1006889A27AA63BA0DDB27A9677B273670A229A8B7E4A6DA88B38E8FB6C4E24A0E4742553E718CC8AD"},
+	{5, "BB6F835CAAEC5B005FC0B2D6EC2E46EFB582B3D078DB1D68960DE1A603C4F098A102C0DF05454C9BFC1CE2A3EDC29A3299EFEA625F0B4B2A836BB7D56951F297"},
+	{6, "EB3BE51C3B628588E198674ACF24B9C166504F0EA19613C2B92BD240F4C9DE7D9F57C09BF95A0F2CA51DD2B79EA5592EA73D4BC6B83611C51FADB0FEB8777E81"},
+	{7, "FCA3E2F062017253C68ABFC45C05AB761E15B7350AC2AE347FFFFCC1E0AA09ED5AEAAA2D35BB2EB28A8D3710A52E92A62E11ECB4B2698AF32ED35A31FD6C81E9"},
+	{9, "5ABBC45C92838362F6FB4B9B64DA43B68D2BE1706BEDBBD053C1509B83A532BAB0E74F3CD9ACB5DAA56E25B290F8B444FA75AA501EEA4A82ADAADDB08024561A"},
+	{15, "2B1C6766E33295004CAFF5D953D34AAF80144F3B506FA2E7F6577AA17D1E09C7A075C5C7474E4FB7F04B8BE3F8D09C18CFB3748EF66250D0FAFFA52456A45CED"},
+	{17, "FAC317094FE0911300B21A13F73C712F420B99DECB4AB6BA785BD82B50395075AC78883893EBBADA8FD02B9ACE9B7E5FBFCFD7888AE1BE1E91CE1840E2DB1FAB"},
+	{255, "8432020A603DD464CBA39312AADFCD85D5C1197F960D942F6867190D521E5089C0789B0C60361DAFB0984CB287A1DE7BD9E2240CCE1A592CAF8753A23114E869"},
+	{256, "961B5F299F750F880FCA004BDF2882E2FE1B491B0C0EE7E2B514C5DFDD53292DBDBEE17E6D3BB5824CDEC1867CC7090963BE8FFF0C1D8ED5864E07CACB50D68A"},
+	{257, "33A74ADE72B92472447035930455DC111BCD4A2D3C61358695A0D868333025BE2C54121354326083451057944114F99E9AE05FDA919092A78F22C761354AD0FE"},
+	{263, "62F56DBD2CF1B00BE89C13CE93B3DE73C25ABF69CE5A45D4E988AE6E8408A97EEA0326D909A32D9DC0B95BD7F0BE3B0588EC1D4CCAE15DB7A62F93825DED1E37"},
+	{511, "B9D19DE07ACC38C241D11D8D6FCA817C347875BCA73F5F38CE4E0FF1D3F32D8269666FCD34BBBAC2F24CC63256E6CF9F738A9672A9A8B613C625848CAC4BBE84"},
+	{512, "15CFA7C1DF8E0D6753D9A9AED0642867E26BB3CF11DF7DAC96F60C274E060FDA941EC41EAFF5F7375F3839632516AE9A831D9F2FBE2BD0FF02E9CF16E99EBD03"},
+	{513, "5F46CEC362D7DB53EE93768AC59E2891F16999241DEF2D38E696B9C6C470F0D968C7C997CB6EFD66497DBB3ECEE0E502C0B915F8D0F47502D85135FD0C9F986D"},
+	{767, "5C836964316983D09E7655EB25B6AA74E0A9AC85117284E7F0D8572A54D6C8E67BD43C5459801076705FFB425866713506AC1AEB3EA9DCAA5DE73A455C8D02F9"},
+	{1023, "BA1F1AB4572FED30B77E651B0ECE6FD6C68296E92A8121550B08606FB0DF72C8604D5A593252C27EF985740C27AE43361A439F8E966C3BCF4B757E533E13A4B8"},
+}
+
+func TestGoldenBits(t *testing.T) {
+	for i := 0; i < len(goldenBits); i++ {
+		g := goldenBits[i]
+		data := make([]byte, (g.nbits+7)/8)
+
+		c := whirlpool.New().(whirlpool.BitHash)
+		c.WriteBits(data, g.nbits)
+		if s := fmt.Sprintf("%X", c.Sum(nil)); s != g.out {
+			t.Fatalf("whirlpool(%d zero bits) = %s want %s", g.nbits, s, g.out)
+		}
+
+		// Feed the same message one bit at a time.
+		c.Reset()
+		for j := uint64(0); j < g.nbits; j++ {
+			c.WriteBits(data[:1], 1)
+		}
+		if s := fmt.Sprintf("%X", c.Sum(nil)); s != g.out {
+			t.Fatalf("whirlpool(%d zero bits, bitwise) = %s want %s", g.nbits, s, g.out)
+		}
+	}
+}
+
+func TestWriteBitsUnaligned(t *testing.T) {
+	// Writing a byte string as 3-bit and 5-bit pieces must match Write.
+	msg := []byte("The quick brown fox jumps over the lazy dog")
+	want := whirlpool.New()
+	want.Write(msg)
+
+	c := whirlpool.New().(whirlpool.BitHash)
+	for _, b := range msg {
+		c.WriteBits([]byte{b}, 3)
+		c.WriteBits([]byte{b << 3}, 5)
+	}
+	if s, w := fmt.Sprintf("%X", c.Sum(nil)), fmt.Sprintf("%X", want.Sum(nil)); s != w {
+		t.Fatalf("whirlpool(split bits) = %s want %s", s, w)
+	}
+
+	// Bits past nbits must be ignored.
+	c.Reset()
+	c.WriteBits([]byte{0x0f}, 4)
+	d := whirlpool.New().(whirlpool.BitHash)
+	d.WriteBits([]byte{0x00}, 4)
+	if s, w := fmt.Sprintf("%X", c.Sum(nil)), fmt.Sprintf("%X", d.Sum(nil)); s != w {
+		t.Fatalf("whirlpool(trailing bits) = %s want %s", s, w)
+	}
+}
+
+// TestWriteMixed switches between whole-byte and bit-granular writes at
+// every position of a message spanning several blocks.
+func TestWriteMixed(t *testing.T) {
+	msg := make([]byte, 5*whirlpool.BlockSize/2)
+	for i := range msg {
+		msg[i] = byte(i*i + 7)
+	}
+
+	// Splitting every byte forces the bit-granular path throughout.
+	ref := whirlpool.New().(whirlpool.BitHash)
+	for _, b := range msg {
+		ref.WriteBits([]byte{b}, 3)
+		ref.WriteBits([]byte{b << 3}, 5)
+	}
+	want := fmt.Sprintf("%X", ref.Sum(nil))
+
+	c := whirlpool.New().(whirlpool.BitHash)
+	for i := range msg {
+		c.Reset()
+		c.Write(msg[:i])
+		c.WriteBits(msg[i:], 3)
+		c.WriteBits([]byte{msg[i] << 3}, 5)
+		c.Write(msg[i+1:])
+		if s := fmt.Sprintf("%X", c.Sum(nil)); s != want {
+			t.Fatalf("whirlpool(split bits at %d) = %s want %s", i, s, want)
+		}
+	}
+}
+
+func TestWriteBitsPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("WriteBits with nbits > 8*len(data) did not panic")
+		}
+	}()
+	whirlpool.New().(whirlpool.BitHash).WriteBits([]byte{0}, 9)
+}
+
+func TestClone(t *testing.T) {
+	// The header spans two blocks and ends 3 bits into byte 22 of the third.
+	header := make([]byte, 2*whirlpool.BlockSize+23)
+	for i := range header {
+		header[i] = byte(i*13 + 5)
+	}
+	const headerBits = 8*(2*whirlpool.BlockSize+22) + 3
+
+	h := whirlpool.New().(whirlpool.BitHash)
+	h.WriteBits(header, headerBits)
+
+	trailers := []struct {
+		data  []byte
+		nbits uint64
+	}{
+		{nil, 0},
+		{[]byte{0xff}, 1},
+		{[]byte("abc"), 24},
+		{[]byte{0xa5, 0x5a}, 13},
+		{bytes.Repeat([]byte{0x3c}, 200), 1600},
+	}
+	for _, tr := range trailers {
+		c, err := h.(hash.Cloner).Clone()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.(whirlpool.BitHash).WriteBits(tr.data, tr.nbits)
+
+		msg, n := appendBits(nil, 0, header, headerBits)
+		msg, n = appendBits(msg, n, tr.data, tr.nbits)
+		want := whirlpool.New().(whirlpool.BitHash)
+		want.WriteBits(msg, n)
+		if s, w := c.Sum(nil), want.Sum(nil); !bytes.Equal(s, w) {
+			t.Fatalf("whirlpool(clone + %d bits) = %X want %X", tr.nbits, s, w)
+		}
+	}
+
+	// Writing to and resetting a clone leaves the original alone.
+	c, err := h.(hash.Cloner).Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.Write([]byte("trailer"))
+	c.Reset()
+	msg, n := appendBits(nil, 0, header, headerBits)
+	want := whirlpool.New().(whirlpool.BitHash)
+	want.WriteBits(msg, n)
+	if s, w := h.Sum(nil), want.Sum(nil); !bytes.Equal(s, w) {
+		t.Fatalf("whirlpool(original) = %X want %X", s, w)
+	}
+
+	// And resetting the original leaves the clone alone.
+	c, err = h.(hash.Cloner).Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Reset()
+	if s, w := c.Sum(nil), want.Sum(nil); !bytes.Equal(s, w) {
+		t.Fatalf("whirlpool(clone after reset) = %X want %X", s, w)
+	}
+}
+
+func TestSum(t *testing.T) {
+	for i := 0; i < len(golden); i++ {
+		g := golden[i]
+		sum := whirlpool.Sum([]byte(g.in))
+		if s := fmt.Sprintf("%X", sum); s != g.out {
+			t.Fatalf("Sum(%s) = %s want %s", g.in, s, g.out)
+		}
+		sum256 := whirlpool.SumPrefix256([]byte(g.in))
+		if s := fmt.Sprintf("%X", sum256); s != g.out[:64] {
+			t.Fatalf("SumPrefix256(%s) = %s want %s", g.in, s, g.out[:64])
+		}
+		sum384 := whirlpool.SumPrefix384([]byte(g.in))
+		if s := fmt.Sprintf("%X", sum384); s != g.out[:96] {
+			t.Fatalf("SumPrefix384(%s) = %s want %s", g.in, s, g.out[:96])
+		}
+	}
+}
+
+func TestSumAllocs(t *testing.T) {
+	data := []byte("The quick brown fox jumps over the lazy dog")
+	if n := testing.AllocsPerRun(10, func() { whirlpool.Sum(data) }); n > 0 {
+		t.Errorf("Sum allocated %v times, want 0", n)
+	}
+}
+
+func BenchmarkSum(b *testing.B) {
+	data := make([]byte, 64)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		whirlpool.Sum(data)
+	}
+}
+
+func BenchmarkNewSum(b *testing.B) {
+	data := make([]byte, 64)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		h := whirlpool.New()
+		h.Write(data)
+		h.Sum(nil)
+	}
+}
+
+var buf = make([]byte, 16<<20)
+
+func benchmarkSize(b *testing.B, size int) {
+	h := whirlpool.New()
+	sum := make([]byte, 0, h.Size())
+	b.SetBytes(int64(size))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		h.Reset()
+		h.Write(buf[:size])
+		h.Sum(sum[:0])
+	}
+}
+
+func BenchmarkHash64(b *testing.B) {
+	benchmarkSize(b, 64)
+}
+
+func BenchmarkHash1K(b *testing.B) {
+	benchmarkSize(b, 1024)
+}
+
+func BenchmarkHash64K(b *testing.B) {
+	benchmarkSize(b, 64<<10)
+}
+
+func BenchmarkHash16M(b *testing.B) {
+	benchmarkSize(b, 16<<20)
+}